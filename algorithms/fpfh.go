@@ -0,0 +1,217 @@
+package points
+
+import (
+	"math"
+
+	"github.com/gonum/matrix/mat64"
+	"golang.org/x/net/context"
+)
+
+// Number of buckets for each of the three angular features of a Fast Point
+// Feature Histogram, as suggested in the paper.
+const fpfhBins = 11
+
+// A Fast Point Feature Histogram, as described in:
+// https://doi.org/10.1109/ROBOT.2009.5152473
+//
+// It describes the shape around a point by the angles between its normal and
+// its neighbors' normals, measured in a frame defined by the line between each
+// pair of points. The neighborhoods and normals are the same ones used for LFSH
+// descriptors, so the radius is the analyzer's LFSHOptions.Radius. Since the
+// angles depend on which way each normal points, descriptors are only
+// comparable if the normals are consistently oriented.
+type FPFHDescriptor struct {
+	// Histograms of the alpha, phi and theta features laid end to end, each
+	// with fpfhBins buckets. Each histogram sums to 1, or to 0 if the point has
+	// no neighbors.
+	Histogram []float64
+	// False if the point's neighborhood is degenerate, in which case the
+	// histograms are all 0. See LFSHOptions.MinNeighbors.
+	Valid bool
+}
+
+// Returns the descriptor as a fixed-length vector, for comparing with the
+// metrics in VectorDistance.
+func (d *FPFHDescriptor) Vector() []float64 {
+	return d.Histogram
+}
+
+// Compares two FPFH descriptors by the Euclidean distance between their
+// vectors. Identical descriptors have a distance of 0.
+func (d *FPFHDescriptor) Distance(other *FPFHDescriptor) (float64, error) {
+	return d.MetricDistance(other, L2)
+}
+
+// Compares two FPFH descriptors' vectors with the given metric.
+func (d *FPFHDescriptor) MetricDistance(other *FPFHDescriptor, metric DescriptorMetric) (float64, error) {
+	return VectorDistance(d.Vector(), other.Vector(), metric)
+}
+
+// Calculates and returns the point's FPFH descriptor: its own simplified
+// histogram plus the average of its neighbors', weighted by how close they
+// are. Check its Valid field before using it.
+func (a *PointCloudAnalyzer) FPFHDescriptor(col int) FPFHDescriptor {
+	n := a.getNeighborhood(col, a.opts.Radius)
+	histogram := make([]float64, 3*fpfhBins)
+	if n.Degenerate() {
+		return FPFHDescriptor{Histogram: histogram}
+	}
+	totalWeight := float64(0)
+	for _, member := range n.members {
+		if member == col {
+			continue
+		}
+		distance := columnDistance(a.universe, col, member)
+		if distance == 0 {
+			continue
+		}
+		other := a.getNeighborhood(member, n.R)
+		if other.Degenerate() {
+			continue
+		}
+		weight := 1 / distance
+		spfh := other.SPFH()
+		for i := range histogram {
+			histogram[i] += weight * spfh[i]
+		}
+		totalWeight += weight
+	}
+	if totalWeight > 0 {
+		for i := range histogram {
+			histogram[i] /= totalWeight
+		}
+	}
+	own := n.SPFH()
+	for i := range histogram {
+		histogram[i] += own[i]
+	}
+	for part := 0; part < 3; part++ {
+		normalize(histogram[part*fpfhBins : (part+1)*fpfhBins])
+	}
+	return FPFHDescriptor{Histogram: histogram, Valid: true}
+}
+
+// Calculates the FPFH descriptors of the points in the given columns, or of
+// every point if cols is nil, in the same way as Descriptors.
+func (a *PointCloudAnalyzer) FPFHDescriptors(ctx context.Context, cols []int, workers int) ([]FPFHDescriptor, error) {
+	if cols == nil {
+		cols = allColumns(a.universe)
+	}
+	descriptors := make([]FPFHDescriptor, len(cols))
+	err := parallel(ctx, len(cols), workers, func(i int) {
+		descriptors[i] = a.FPFHDescriptor(cols[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return descriptors, nil
+}
+
+// Returns the Simplified Point Feature Histogram of the neighborhood's center:
+// the alpha, phi and theta features between it and each of its neighbors,
+// binned and normalized like FPFHDescriptor.Histogram.
+func (n *neighborhood) SPFH() []float64 {
+	n.spfhOnce.Do(func() {
+		n.spfh = make([]float64, 3*fpfhBins)
+		center := mat64.Col(nil, 0, &n.Center)
+		normal := unitNormal(n)
+		for _, member := range n.members {
+			other := n.universe.getNeighborhood(member, n.R)
+			if other.Degenerate() {
+				continue
+			}
+			point := mat64.Col(nil, member, n.universe.universe)
+			otherNormal := unitNormal(other)
+			alpha, phi, theta, ok := pairFeatures(center, normal, point, otherNormal)
+			if !ok {
+				continue
+			}
+			n.spfh[fpfhBin(alpha, -1, 1)]++
+			n.spfh[fpfhBins+fpfhBin(phi, -1, 1)]++
+			n.spfh[2*fpfhBins+fpfhBin(theta, -math.Pi, math.Pi)]++
+		}
+		for part := 0; part < 3; part++ {
+			normalize(n.spfh[part*fpfhBins : (part+1)*fpfhBins])
+		}
+	})
+	return n.spfh
+}
+
+// Computes the angular features between two points and their unit normals.
+// The point whose normal is closer to the line between them is used as the
+// source, so that the result doesn't depend on the order they're given in.
+// Returns false if the points are the same or the frame is degenerate.
+func pairFeatures(p1, n1, p2, n2 []float64) (alpha, phi, theta float64, ok bool) {
+	dp := []float64{p2[0] - p1[0], p2[1] - p1[1], p2[2] - p1[2]}
+	distance := math.Sqrt(dot3(dp, dp))
+	if distance == 0 {
+		return 0, 0, 0, false
+	}
+	angle1 := dot3(n1, dp) / distance
+	angle2 := dot3(n2, dp) / distance
+	if math.Acos(math.Min(1, math.Abs(angle1))) > math.Acos(math.Min(1, math.Abs(angle2))) {
+		n1, n2 = n2, n1
+		dp = []float64{-dp[0], -dp[1], -dp[2]}
+		phi = -angle2
+	} else {
+		phi = angle1
+	}
+
+	// Darboux frame, as in the paper: u is the source normal, v = u x (p2-p1)
+	// is perpendicular to it and the line between the points, and w = u x v
+	// completes the frame. PCL crosses u and p2-p1 the other way around, which
+	// negates alpha and theta.
+	u := n1
+	v := cross3(u, dp)
+	vNorm := math.Sqrt(dot3(v, v))
+	if vNorm == 0 {
+		return 0, 0, 0, false
+	}
+	for i := range v {
+		v[i] /= vNorm
+	}
+	w := cross3(u, v)
+	alpha = dot3(v, n2)
+	theta = math.Atan2(dot3(w, n2), dot3(u, n2))
+	return alpha, phi, theta, true
+}
+
+func unitNormal(n *neighborhood) []float64 {
+	normal := unit(n.Normal())
+	return mat64.Col(nil, 0, &normal)
+}
+
+// Returns the bucket of value, which should be between min and max.
+func fpfhBin(value, min, max float64) int {
+	bin := int(math.Floor(fpfhBins * (value - min) / (max - min)))
+	if bin < 0 {
+		return 0
+	}
+	if bin >= fpfhBins {
+		return fpfhBins - 1
+	}
+	return bin
+}
+
+// Scales v so that it sums to 1, unless it sums to 0.
+func normalize(v []float64) {
+	total := sum(v)
+	if total == 0 {
+		return
+	}
+	for i := range v {
+		v[i] /= total
+	}
+}
+
+func dot3(a, b []float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func cross3(a, b []float64) []float64 {
+	return []float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
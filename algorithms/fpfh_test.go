@@ -0,0 +1,35 @@
+package points
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPairFeatures(t *testing.T) {
+	// Each component of a unit vector at 45 degrees to two axes.
+	diag := 1 / math.Sqrt2
+	origin, x := []float64{0, 0, 0}, []float64{1, 0, 0}
+	up, tilted := []float64{0, 0, 1}, []float64{diag, 0, diag}
+	for _, tc := range []struct {
+		desc              string
+		p1, n1, p2, n2    []float64
+		alpha, phi, theta float64
+		ok                bool
+	}{
+		// v = (0, 1, 0) and w = (-1, 0, 0).
+		{"normal tilted toward v", origin, up, x, []float64{0, diag, diag}, diag, 0, 0, true},
+		// v = (0, 1, 0) and w = (-1, 0, 1)/sqrt(2).
+		{"source tilted toward the target", origin, tilted, x, up, 0, diag, math.Pi / 4, true},
+		// The tilted normal is closer to the line between the points, so
+		// it's still the source.
+		{"source given second", x, up, origin, tilted, 0, diag, math.Pi / 4, true},
+		{"same point", origin, up, origin, tilted, 0, 0, 0, false},
+		// v would be 0.
+		{"normal along the line", origin, x, x, up, 0, 0, 0, false},
+	} {
+		alpha, phi, theta, ok := pairFeatures(tc.p1, tc.n1, tc.p2, tc.n2)
+		if ok != tc.ok || math.Abs(alpha-tc.alpha) > 1e-9 || math.Abs(phi-tc.phi) > 1e-9 || math.Abs(theta-tc.theta) > 1e-9 {
+			t.Errorf("%s: got alpha, phi, theta %v, %v, %v, %v. want %v, %v, %v, %v", tc.desc, alpha, phi, theta, ok, tc.alpha, tc.phi, tc.theta, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package points
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// The error that ICP minimizes.
+type ICPMetric int
+
+const (
+	// Squared distance between corresponding points.
+	PointToPoint ICPMetric = iota
+	// Squared distance from each source point to the plane through its
+	// corresponding target point, perpendicular to the target's normal. Slides
+	// along flat surfaces freely, so it usually converges in far fewer
+	// iterations than PointToPoint.
+	PointToPlane
+)
+
+func (m ICPMetric) String() string {
+	switch m {
+	case PointToPoint:
+		return "PointToPoint"
+	case PointToPlane:
+		return "PointToPlane"
+	}
+	return fmt.Sprintf("ICPMetric(%d)", int(m))
+}
+
+type ICPOptions struct {
+	Metric ICPMetric
+	// 4x4 transform to start from. If nil, the identity is used.
+	Initial *mat64.Dense
+	// Most iterations to run before giving up on converging.
+	MaxIterations int
+	// Source points whose nearest target point is farther away than this are
+	// ignored. If 0, every source point is used.
+	MaxCorrespondenceDistance float64
+	// ICP has converged once an iteration moves the source by less than both
+	// of these, in the cloud's units and radians respectively.
+	TranslationTolerance float64
+	RotationTolerance    float64
+	// ICP has also converged once an iteration changes the RMSE by less than
+	// this fraction of it.
+	RelativeRMSETolerance float64
+	// Radius of the neighborhoods used to estimate the target's normals for
+	// PointToPlane.
+	NormalRadius float64
+	// Fewer correspondences than this and the fit is considered to have
+	// failed. Must be at least 3 for PointToPoint and 6 for PointToPlane.
+	MinInliers int
+}
+
+// Returns options suited to aligning consecutive frames from a depth sensor,
+// measured in meters.
+func DefaultICPOptions() ICPOptions {
+	return ICPOptions{
+		Metric:                    PointToPlane,
+		MaxIterations:             30,
+		MaxCorrespondenceDistance: 0.1,
+		TranslationTolerance:      1e-5,
+		RotationTolerance:         1e-5,
+		RelativeRMSETolerance:     1e-6,
+		NormalRadius:              DefaultLFSHOptions().Radius,
+		MinInliers:                10,
+	}
+}
+
+type ICPResult struct {
+	// 4x4 homogeneous transform that maps source points onto the target.
+	Transform *mat64.Dense
+	// Root mean square of the metric's distance between corresponding points
+	// once transformed.
+	RMSE float64
+	// Number of correspondences used in the final fit.
+	Inliers    int
+	Iterations int
+	// False if MaxIterations was reached before converging. The transform is
+	// still the best found.
+	Converged bool
+}
+
+// Aligns source to target with iterative closest point. Both are 3xN matrices
+// with one point per column, like those given to PointCloudAnalyzer.
+func ICP(source, target *mat64.Dense, opts ICPOptions) (ICPResult, error) {
+	if r, _ := source.Dims(); r != 3 {
+		return ICPResult{}, fmt.Errorf("expected a 3xN source. got %d rows", r)
+	}
+	if r, _ := target.Dims(); r != 3 {
+		return ICPResult{}, fmt.Errorf("expected a 3xN target. got %d rows", r)
+	}
+	if opts.MaxIterations <= 0 {
+		return ICPResult{}, fmt.Errorf("expected a positive MaxIterations. got %d", opts.MaxIterations)
+	}
+	minInliers := 3
+	var normals []mat64.Vector
+	switch opts.Metric {
+	case PointToPoint:
+	case PointToPlane:
+		minInliers = 6
+		if opts.NormalRadius <= 0 {
+			return ICPResult{}, fmt.Errorf("expected a positive NormalRadius. got %v", opts.NormalRadius)
+		}
+		normals = targetNormals(target, opts.NormalRadius)
+	default:
+		return ICPResult{}, fmt.Errorf("unknown ICP metric: %v", opts.Metric)
+	}
+	if opts.MinInliers < minInliers {
+		return ICPResult{}, fmt.Errorf("expected MinInliers of at least %d for %v. got %d", minInliers, opts.Metric, opts.MinInliers)
+	}
+
+	// Targets without a normal can't be used for PointToPlane, so they're left
+	// out of the index used to find correspondences.
+	var usable []int
+	_, tc := target.Dims()
+	for j := 0; j < tc; j++ {
+		if normals == nil || normals[j].Len() > 0 {
+			usable = append(usable, j)
+		}
+	}
+	index := targetIndex{cols: usable, tree: NewKDTree(selectColumns(target, usable))}
+
+	transform := mat64.NewDense(4, 4, nil)
+	if opts.Initial != nil {
+		if r, c := opts.Initial.Dims(); r != 4 || c != 4 {
+			return ICPResult{}, fmt.Errorf("expected a 4x4 initial transform. got %dx%d", r, c)
+		}
+		transform.Clone(opts.Initial)
+	} else {
+		for i := 0; i < 4; i++ {
+			transform.Set(i, i, 1)
+		}
+	}
+
+	result := ICPResult{}
+	previousRMSE := math.Inf(1)
+	for result.Iterations < opts.MaxIterations {
+		moved := TransformPoints(transform, source)
+		pairs := index.correspondences(moved, opts.MaxCorrespondenceDistance)
+		if len(pairs) < opts.MinInliers {
+			return ICPResult{}, fmt.Errorf("found %d correspondences. need at least %d", len(pairs), opts.MinInliers)
+		}
+		rmse := pairsRMSE(moved, target, normals, pairs, opts.Metric)
+
+		var step *mat64.Dense
+		var err error
+		if opts.Metric == PointToPoint {
+			step, err = pointToPointStep(moved, target, pairs)
+		} else {
+			step, err = pointToPlaneStep(moved, target, normals, pairs)
+		}
+		if err != nil {
+			return ICPResult{}, err
+		}
+		next := mat64.NewDense(4, 4, nil)
+		next.Mul(step, transform)
+		transform = next
+		result.Iterations++
+
+		translation, rotation := transformSize(step)
+		if translation < opts.TranslationTolerance && rotation < opts.RotationTolerance ||
+			math.Abs(previousRMSE-rmse) <= opts.RelativeRMSETolerance*rmse {
+			result.Converged = true
+			break
+		}
+		previousRMSE = rmse
+	}
+
+	moved := TransformPoints(transform, source)
+	pairs := index.correspondences(moved, opts.MaxCorrespondenceDistance)
+	if len(pairs) < opts.MinInliers {
+		return ICPResult{}, fmt.Errorf("found %d correspondences. need at least %d", len(pairs), opts.MinInliers)
+	}
+	result.Transform = transform
+	result.Inliers = len(pairs)
+	result.RMSE = pairsRMSE(moved, target, normals, pairs, opts.Metric)
+	return result, nil
+}
+
+// A source column and the target column nearest to it.
+type correspondence struct {
+	source, target int
+}
+
+// A KD-tree over a subset of a target cloud's columns.
+type targetIndex struct {
+	// The tree's column i is the target's column cols[i].
+	cols []int
+	tree *KDTree
+}
+
+// Pairs each source point with its nearest indexed target point. Pairs farther
+// apart than maxDistance are dropped, unless maxDistance is 0.
+func (index targetIndex) correspondences(source *mat64.Dense, maxDistance float64) []correspondence {
+	_, sc := source.Dims()
+	var pairs []correspondence
+	point := make([]float64, 3)
+	for i := 0; i < sc; i++ {
+		mat64.Col(point, i, source)
+		nearest, distance := index.tree.Nearest(point)
+		if nearest < 0 || maxDistance > 0 && distance > maxDistance {
+			continue
+		}
+		pairs = append(pairs, correspondence{source: i, target: index.cols[nearest]})
+	}
+	return pairs
+}
+
+// Returns the given columns of m as a new matrix.
+func selectColumns(m *mat64.Dense, cols []int) *mat64.Dense {
+	r, _ := m.Dims()
+	selected := mat64.NewDense(r, len(cols), nil)
+	for k, col := range cols {
+		for i := 0; i < r; i++ {
+			selected.Set(i, k, m.At(i, col))
+		}
+	}
+	return selected
+}
+
+func pairsRMSE(source, target *mat64.Dense, normals []mat64.Vector, pairs []correspondence, metric ICPMetric) float64 {
+	sum := float64(0)
+	for _, p := range pairs {
+		d := [3]float64{}
+		for i := 0; i < 3; i++ {
+			d[i] = source.At(i, p.source) - target.At(i, p.target)
+		}
+		if metric == PointToPlane {
+			n := normals[p.target]
+			dist := d[0]*n.At(0, 0) + d[1]*n.At(1, 0) + d[2]*n.At(2, 0)
+			sum += dist * dist
+		} else {
+			sum += d[0]*d[0] + d[1]*d[1] + d[2]*d[2]
+		}
+	}
+	return math.Sqrt(sum / float64(len(pairs)))
+}
+
+// Returns the transform that best maps paired source points onto their
+// targets.
+func pointToPointStep(source, target *mat64.Dense, pairs []correspondence) (*mat64.Dense, error) {
+	from := mat64.NewDense(3, len(pairs), nil)
+	to := mat64.NewDense(3, len(pairs), nil)
+	for k, p := range pairs {
+		for i := 0; i < 3; i++ {
+			from.Set(i, k, source.At(i, p.source))
+			to.Set(i, k, target.At(i, p.target))
+		}
+	}
+	return RigidTransform(from, to)
+}
+
+// Returns the transform that minimizes the point to plane distance of paired
+// points, assuming the rotation needed is small enough to linearize: R is
+// approximated as I + [w]x, so each pair contributes a row of
+//
+//	((s x n) . w) + (n . t) = n . (d - s)
+//
+// for source s, target d and target normal n.
+func pointToPlaneStep(source, target *mat64.Dense, normals []mat64.Vector, pairs []correspondence) (*mat64.Dense, error) {
+	// Accumulate the normal equations AtA x = Atb directly rather than
+	// building A, which has a row per pair.
+	ata := mat64.NewDense(6, 6, nil)
+	atb := mat64.NewDense(6, 1, nil)
+	for _, p := range pairs {
+		s := [3]float64{source.At(0, p.source), source.At(1, p.source), source.At(2, p.source)}
+		d := [3]float64{target.At(0, p.target), target.At(1, p.target), target.At(2, p.target)}
+		n := [3]float64{normals[p.target].At(0, 0), normals[p.target].At(1, 0), normals[p.target].At(2, 0)}
+		row := [6]float64{
+			s[1]*n[2] - s[2]*n[1],
+			s[2]*n[0] - s[0]*n[2],
+			s[0]*n[1] - s[1]*n[0],
+			n[0], n[1], n[2],
+		}
+		b := n[0]*(d[0]-s[0]) + n[1]*(d[1]-s[1]) + n[2]*(d[2]-s[2])
+		for i := 0; i < 6; i++ {
+			for j := 0; j < 6; j++ {
+				ata.Set(i, j, ata.At(i, j)+row[i]*row[j])
+			}
+			atb.Set(i, 0, atb.At(i, 0)+row[i]*b)
+		}
+	}
+	var x mat64.Dense
+	if err := x.Solve(ata, atb); err != nil {
+		return nil, errors.New("point to plane fit is degenerate: the target's surfaces don't constrain every direction")
+	}
+
+	// Turn the rotation vector back into a proper rotation with Rodrigues'
+	// formula so that errors from linearizing don't accumulate as scaling.
+	w := [3]float64{x.At(0, 0), x.At(1, 0), x.At(2, 0)}
+	step := rotationMatrix(w)
+	for i := 0; i < 3; i++ {
+		step.Set(i, 3, x.At(3+i, 0))
+	}
+	return step, nil
+}
+
+// Returns the 4x4 transform that rotates by |w| radians about w.
+func rotationMatrix(w [3]float64) *mat64.Dense {
+	m := mat64.NewDense(4, 4, nil)
+	m.Set(3, 3, 1)
+	angle := math.Sqrt(w[0]*w[0] + w[1]*w[1] + w[2]*w[2])
+	if angle == 0 {
+		for i := 0; i < 3; i++ {
+			m.Set(i, i, 1)
+		}
+		return m
+	}
+	x, y, z := w[0]/angle, w[1]/angle, w[2]/angle
+	c, s := math.Cos(angle), math.Sin(angle)
+	t := 1 - c
+	rotation := [3][3]float64{
+		{t*x*x + c, t*x*y - s*z, t*x*z + s*y},
+		{t*x*y + s*z, t*y*y + c, t*y*z - s*x},
+		{t*x*z - s*y, t*y*z + s*x, t*z*z + c},
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m.Set(i, j, rotation[i][j])
+		}
+	}
+	return m
+}
+
+// Returns how far a 4x4 rigid transform translates, and how many radians it
+// rotates.
+func transformSize(m *mat64.Dense) (translation, rotation float64) {
+	translation = math.Sqrt(m.At(0, 3)*m.At(0, 3) + m.At(1, 3)*m.At(1, 3) + m.At(2, 3)*m.At(2, 3))
+	cos := (m.At(0, 0) + m.At(1, 1) + m.At(2, 2) - 1) / 2
+	return translation, math.Acos(math.Max(-1, math.Min(1, cos)))
+}
+
+// Estimates a unit normal for each target point from its neighborhood. Points
+// with too few neighbors, or too nearly collinear ones, to define a plane get
+// an empty vector.
+func targetNormals(target *mat64.Dense, radius float64) []mat64.Vector {
+	analyzer := &PointCloudAnalyzer{}
+	analyzer.MakePointCloudAnalyzer(target)
+	_, c := target.Dims()
+	normals := make([]mat64.Vector, c)
+	for j := 0; j < c; j++ {
+		// Neighborhoods aren't reused, so there's no point caching them.
+		n := analyzer.implGetNeighborhood(j, radius)
+		if _, size := n.Dims(); size < 3 || n.collinear() {
+			continue
+		}
+		// Point to plane distances don't depend on which way normals point.
+		normals[j] = n.unorientedNormal()
+	}
+	return normals
+}
@@ -0,0 +1,84 @@
+package points
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// Returns points sampled from the floor, left wall and back wall of a room's
+// corner 1.5 to 2.5 meters in front of the sensor. Unlike a single plane, it
+// constrains every degree of freedom of a rigid transform.
+func roomCorner(r *rand.Rand, perWall int) *mat64.Dense {
+	m := mat64.NewDense(3, 3*perWall, nil)
+	for i := 0; i < perWall; i++ {
+		u, v := r.Float64()-0.5, r.Float64()
+		m.SetCol(3*i, []float64{u, -0.5, 1.5 + v})
+		m.SetCol(3*i+1, []float64{-0.5, u, 1.5 + v})
+		m.SetCol(3*i+2, []float64{u, v - 0.5, 2.5})
+	}
+	return m
+}
+
+// Returns a 4x4 transform that rotates by angle radians about the y axis and
+// then translates by (x, y, z).
+func yawTransform(angle, x, y, z float64) *mat64.Dense {
+	sin, cos := math.Sincos(angle)
+	return mat64.NewDense(4, 4, []float64{
+		cos, 0, sin, x,
+		0, 1, 0, y,
+		-sin, 0, cos, z,
+		0, 0, 0, 1,
+	})
+}
+
+func TestICPRecoversKnownTransform(t *testing.T) {
+	target := roomCorner(rand.New(rand.NewSource(1)), 600)
+	want := yawTransform(0.01, 0.01, -0.005, 0.004)
+	var inverse mat64.Dense
+	if err := inverse.Inverse(want); err != nil {
+		t.Fatal(err)
+	}
+	source := TransformPoints(&inverse, target)
+	for _, metric := range []ICPMetric{PointToPoint, PointToPlane} {
+		opts := DefaultICPOptions()
+		opts.Metric = metric
+		result, err := ICP(source, target, opts)
+		if err != nil {
+			t.Errorf("%v: %v", metric, err)
+			continue
+		}
+		if !mat64.EqualApprox(result.Transform, want, 1e-3) {
+			t.Errorf("%v: got transform\n%v\nwant\n%v", metric, mat64.Formatted(result.Transform), mat64.Formatted(want))
+		}
+		if !result.Converged {
+			t.Errorf("%v: didn't converge in %d iterations", metric, result.Iterations)
+		}
+		if _, c := source.Dims(); result.Inliers != c {
+			t.Errorf("%v: got %d inliers. want every one of the %d points", metric, result.Inliers, c)
+		}
+	}
+}
+
+func TestICPStartsFromInitial(t *testing.T) {
+	target := roomCorner(rand.New(rand.NewSource(2)), 600)
+	// Too far for ICP to find on its own, since most points would be matched
+	// with the wrong wall.
+	want := yawTransform(0.3, 0.2, 0, -0.1)
+	var inverse mat64.Dense
+	if err := inverse.Inverse(want); err != nil {
+		t.Fatal(err)
+	}
+	source := TransformPoints(&inverse, target)
+	opts := DefaultICPOptions()
+	opts.Initial = yawTransform(0.29, 0.19, 0, -0.1)
+	result, err := ICP(source, target, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mat64.EqualApprox(result.Transform, want, 1e-3) {
+		t.Errorf("got transform\n%v\nwant\n%v", mat64.Formatted(result.Transform), mat64.Formatted(want))
+	}
+}
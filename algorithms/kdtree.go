@@ -0,0 +1,192 @@
+package points
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// A KD-tree over the points in a 3xN matrix, for finding the points near a
+// location without comparing against every point. Points are referred to by
+// their column in the matrix. The tree is a snapshot: changes to the matrix
+// after building it aren't seen.
+//
+// The tree is implicit: order holds the columns arranged so that the median
+// of each range along the axis for its depth sits at the range's middle, with
+// no greater points before it and no lesser points after it. Each half is then
+// arranged the same way along the next axis.
+type KDTree struct {
+	// Coordinates of column i are coords[3*i : 3*i+3].
+	coords []float64
+	order  []int
+}
+
+func NewKDTree(points *mat64.Dense) *KDTree {
+	_, c := points.Dims()
+	t := &KDTree{
+		coords: make([]float64, 3*c),
+		order:  make([]int, c),
+	}
+	for j := 0; j < c; j++ {
+		for i := 0; i < 3; i++ {
+			t.coords[3*j+i] = points.At(i, j)
+		}
+		t.order[j] = j
+	}
+	t.build(0, c, 0)
+	return t
+}
+
+func (t *KDTree) Len() int {
+	return len(t.order)
+}
+
+func (t *KDTree) build(lo, hi, depth int) {
+	if hi-lo <= 1 {
+		return
+	}
+	mid := (lo + hi) / 2
+	t.selectNth(lo, hi, mid, depth%3)
+	t.build(lo, mid, depth+1)
+	t.build(mid+1, hi, depth+1)
+}
+
+// Rearranges order[lo:hi] so that order[n] holds the column that would be
+// there if the range were sorted along axis, with no greater columns before it
+// and no lesser ones after it.
+func (t *KDTree) selectNth(lo, hi, n, axis int) {
+	value := func(k int) float64 { return t.coords[3*t.order[k]+axis] }
+	for hi-lo > 1 {
+		// Partition into columns less than, equal to, and greater than the
+		// middle column's value. Keeping equal values together means that
+		// points sharing a coordinate, like those on a flat wall, don't make
+		// this quadratic.
+		pivot := value((lo + hi) / 2)
+		less, k, greater := lo, lo, hi
+		for k < greater {
+			switch v := value(k); {
+			case v < pivot:
+				t.order[k], t.order[less] = t.order[less], t.order[k]
+				less++
+				k++
+			case v > pivot:
+				greater--
+				t.order[k], t.order[greater] = t.order[greater], t.order[k]
+			default:
+				k++
+			}
+		}
+		switch {
+		case n < less:
+			hi = less
+		case n >= greater:
+			lo = greater
+		default:
+			return
+		}
+	}
+}
+
+func (t *KDTree) squaredDistance(col int, point []float64) float64 {
+	dx := t.coords[3*col] - point[0]
+	dy := t.coords[3*col+1] - point[1]
+	dz := t.coords[3*col+2] - point[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+// Returns the columns of every point within radius of point, which holds x,
+// y and z, in no particular order.
+func (t *KDTree) InRadius(point []float64, radius float64) []int {
+	var cols []int
+	t.inRadius(0, len(t.order), 0, point, radius, &cols)
+	return cols
+}
+
+func (t *KDTree) inRadius(lo, hi, depth int, point []float64, radius float64, cols *[]int) {
+	if lo >= hi {
+		return
+	}
+	mid := (lo + hi) / 2
+	col := t.order[mid]
+	if t.squaredDistance(col, point) <= radius*radius {
+		*cols = append(*cols, col)
+	}
+	diff := point[depth%3] - t.coords[3*col+depth%3]
+	if diff-radius <= 0 {
+		t.inRadius(lo, mid, depth+1, point, radius, cols)
+	}
+	if diff+radius >= 0 {
+		t.inRadius(mid+1, hi, depth+1, point, radius, cols)
+	}
+}
+
+// Returns the columns of the k points nearest to point, which holds x, y and
+// z, from nearest to farthest. Returns fewer if the tree has fewer than k
+// points.
+func (t *KDTree) KNearest(point []float64, k int) []int {
+	if k <= 0 {
+		return nil
+	}
+	nearest := &neighborHeap{}
+	t.kNearest(0, len(t.order), 0, point, k, nearest)
+	cols := make([]int, nearest.Len())
+	for i := len(cols) - 1; i >= 0; i-- {
+		cols[i] = heap.Pop(nearest).(neighbor).col
+	}
+	return cols
+}
+
+// Returns the column of the point nearest to point, and the distance to it.
+// Returns -1 if the tree is empty.
+func (t *KDTree) Nearest(point []float64) (int, float64) {
+	cols := t.KNearest(point, 1)
+	if len(cols) == 0 {
+		return -1, math.Inf(1)
+	}
+	return cols[0], math.Sqrt(t.squaredDistance(cols[0], point))
+}
+
+func (t *KDTree) kNearest(lo, hi, depth int, point []float64, k int, nearest *neighborHeap) {
+	if lo >= hi {
+		return
+	}
+	mid := (lo + hi) / 2
+	col := t.order[mid]
+	if d := t.squaredDistance(col, point); nearest.Len() < k {
+		heap.Push(nearest, neighbor{col: col, squaredDistance: d})
+	} else if d < (*nearest)[0].squaredDistance {
+		(*nearest)[0] = neighbor{col: col, squaredDistance: d}
+		heap.Fix(nearest, 0)
+	}
+	diff := point[depth%3] - t.coords[3*col+depth%3]
+	near, far := [2]int{lo, mid}, [2]int{mid + 1, hi}
+	if diff > 0 {
+		near, far = far, near
+	}
+	t.kNearest(near[0], near[1], depth+1, point, k, nearest)
+	// The far side can only hold a nearer point if the splitting plane is
+	// closer than the farthest point found so far.
+	if nearest.Len() < k || diff*diff < (*nearest)[0].squaredDistance {
+		t.kNearest(far[0], far[1], depth+1, point, k, nearest)
+	}
+}
+
+type neighbor struct {
+	col             int
+	squaredDistance float64
+}
+
+// A max heap of neighbors, so the farthest one found so far is at the top.
+type neighborHeap []neighbor
+
+func (h neighborHeap) Len() int            { return len(h) }
+func (h neighborHeap) Less(i, j int) bool  { return h[i].squaredDistance > h[j].squaredDistance }
+func (h neighborHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *neighborHeap) Push(x interface{}) { *h = append(*h, x.(neighbor)) }
+func (h *neighborHeap) Pop() interface{} {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
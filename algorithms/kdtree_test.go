@@ -0,0 +1,185 @@
+package points
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// Returns n random points in the unit cube. Their y coordinates are rounded to
+// tenths so that many points share a coordinate, like points on a wall do.
+func randomCloud(r *rand.Rand, n int) *mat64.Dense {
+	m := mat64.NewDense(3, n, nil)
+	for j := 0; j < n; j++ {
+		m.Set(0, j, r.Float64())
+		m.Set(1, j, math.Floor(r.Float64()*10)/10)
+		m.Set(2, j, r.Float64())
+	}
+	return m
+}
+
+// Returns the points a width by height depth sensor with a 58.5 by 46.6
+// degree field of view would see looking at a gently curved wall 1.5 meters
+// away, with one point per pixel.
+func sensorCloud(width, height int) *mat64.Dense {
+	m := mat64.NewDense(3, width*height, nil)
+	tanX, tanY := math.Tan(58.5/2*math.Pi/180), math.Tan(46.6/2*math.Pi/180)
+	for row := 0; row < height; row++ {
+		for col := 0; col < width; col++ {
+			x := (2*float64(col)/float64(width-1) - 1) * tanX
+			y := (1 - 2*float64(row)/float64(height-1)) * tanY
+			z := 1.5 + 0.1*math.Sin(3*x)*math.Cos(3*y)
+			j := row*width + col
+			m.Set(0, j, x*z)
+			m.Set(1, j, y*z)
+			m.Set(2, j, z)
+		}
+	}
+	return m
+}
+
+func squaredColumnDistance(m *mat64.Dense, col int, point []float64) float64 {
+	dx, dy, dz := m.At(0, col)-point[0], m.At(1, col)-point[1], m.At(2, col)-point[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+func TestKDTreeMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const n = 2000
+	points := randomCloud(r, n)
+	tree := NewKDTree(points)
+	if tree.Len() != n {
+		t.Fatalf("got a tree of %d points. want %d", tree.Len(), n)
+	}
+	for q := 0; q < 100; q++ {
+		query := []float64{r.Float64(), r.Float64(), r.Float64()}
+		// Sorted by distance, and then by column so that ties are in a
+		// predictable order.
+		cols := make([]int, n)
+		for j := range cols {
+			cols[j] = j
+		}
+		sort.Slice(cols, func(i, j int) bool {
+			di, dj := squaredColumnDistance(points, cols[i], query), squaredColumnDistance(points, cols[j], query)
+			if di != dj {
+				return di < dj
+			}
+			return cols[i] < cols[j]
+		})
+
+		const radius = 0.1
+		var want []int
+		for _, col := range cols {
+			if squaredColumnDistance(points, col, query) <= radius*radius {
+				want = append(want, col)
+			}
+		}
+		sort.Ints(want)
+		got := tree.InRadius(query, radius)
+		sort.Ints(got)
+		if len(got) != len(want) {
+			t.Fatalf("InRadius(%v, %v) found %d points. want %d", query, radius, len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("InRadius(%v, %v) = %v. want %v", query, radius, got, want)
+			}
+		}
+
+		const k = 8
+		nearest := tree.KNearest(query, k)
+		if len(nearest) != k {
+			t.Fatalf("KNearest(%v, %d) found %d points", query, k, len(nearest))
+		}
+		for i, col := range nearest {
+			// Compared by distance since points at the same distance may come
+			// back in either order.
+			got, want := squaredColumnDistance(points, col, query), squaredColumnDistance(points, cols[i], query)
+			if got != want {
+				t.Fatalf("KNearest(%v, %d)[%d] is %v away. want %v", query, k, i, math.Sqrt(got), math.Sqrt(want))
+			}
+		}
+
+		col, distance := tree.Nearest(query)
+		if want := math.Sqrt(squaredColumnDistance(points, cols[0], query)); distance != want || math.Sqrt(squaredColumnDistance(points, col, query)) != want {
+			t.Fatalf("Nearest(%v) = %d, %v. want a point %v away", query, col, distance, want)
+		}
+	}
+}
+
+func TestKDTreeSmall(t *testing.T) {
+	empty := NewKDTree(mat64.NewDense(3, 0, nil))
+	if col, _ := empty.Nearest([]float64{0, 0, 0}); col != -1 {
+		t.Errorf("got nearest column %d in an empty tree. want -1", col)
+	}
+	if got := empty.InRadius([]float64{0, 0, 0}, 1); len(got) != 0 {
+		t.Errorf("got %v in an empty tree", got)
+	}
+	tree := NewKDTree(mat64.NewDense(3, 3, []float64{
+		0, 1, 2,
+		0, 0, 0,
+		0, 0, 0,
+	}))
+	if got := tree.KNearest([]float64{1.9, 0, 0}, 5); len(got) != 3 || got[0] != 2 || got[1] != 1 || got[2] != 0 {
+		t.Errorf("got %v. want every column from nearest to farthest: [2 1 0]", got)
+	}
+	if got := tree.KNearest([]float64{0, 0, 0}, 0); len(got) != 0 {
+		t.Errorf("got %v for k of 0", got)
+	}
+}
+
+// Finds a neighborhood the way PointCloudAnalyzer did before it had a
+// KDTree, by comparing against every point.
+func linearNeighborhood(points *mat64.Dense, col int, radius float64) *mat64.Dense {
+	_, c := points.Dims()
+	point := mat64.Col(nil, col, points)
+	var members []int
+	for j := 0; j < c; j++ {
+		if squaredColumnDistance(points, j, point) <= radius*radius {
+			members = append(members, j)
+		}
+	}
+	neighborhood := mat64.NewDense(3, len(members), nil)
+	for index, k := range members {
+		neighborhood.SetCol(index, mat64.Col(nil, k, points))
+	}
+	return neighborhood
+}
+
+// A frame at half of the Kinect's 640x480 depth resolution, with the default
+// LFSH radius.
+const (
+	benchmarkWidth  = 320
+	benchmarkHeight = 240
+	benchmarkRadius = 0.1
+)
+
+func BenchmarkNeighborhoodKDTree(b *testing.B) {
+	points := sensorCloud(benchmarkWidth, benchmarkHeight)
+	a := &PointCloudAnalyzer{}
+	a.MakePointCloudAnalyzer(points)
+	_, c := points.Dims()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.implGetNeighborhood(i*7919%c, benchmarkRadius)
+	}
+}
+
+func BenchmarkNeighborhoodLinearScan(b *testing.B) {
+	points := sensorCloud(benchmarkWidth, benchmarkHeight)
+	_, c := points.Dims()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		linearNeighborhood(points, i*7919%c, benchmarkRadius)
+	}
+}
+
+func BenchmarkNewKDTree(b *testing.B) {
+	points := sensorCloud(benchmarkWidth, benchmarkHeight)
+	for i := 0; i < b.N; i++ {
+		NewKDTree(points)
+	}
+}
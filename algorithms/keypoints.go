@@ -0,0 +1,134 @@
+package points
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/gonum/matrix/mat64"
+	"golang.org/x/net/context"
+)
+
+// Intrinsic Shape Signatures keypoint detection, as described in:
+// https://doi.org/10.1109/ICCVW.2009.5457637
+//
+// A point is a keypoint if its neighborhood spreads out differently along each
+// of its principal axes, so that the axes are well defined, and it varies the
+// most from its surface, measured by the smallest eigenvalue, of the points
+// around it.
+type ISSOptions struct {
+	// A point is only a keypoint if the ratio of the second largest eigenvalue
+	// of its neighborhood to the largest is below Gamma21, and the ratio of the
+	// smallest to the second largest is below Gamma32. Both are between 0 and
+	// 1, and lower values keep fewer points.
+	Gamma21 float64
+	Gamma32 float64
+	// A point is only a keypoint if its smallest eigenvalue is the largest of
+	// the points within this distance of it. In whatever units the coordinate
+	// system is in.
+	NonMaxRadius float64
+	// Points whose neighborhoods have fewer points than this can't be
+	// keypoints, since their eigenvalues are unreliable. At least 3.
+	MinNeighbors int
+}
+
+// Returns options suited to clouds measured in meters, with the neighborhood
+// radius from DefaultLFSHOptions.
+func DefaultISSOptions() ISSOptions {
+	return ISSOptions{
+		Gamma21:      0.975,
+		Gamma32:      0.975,
+		NonMaxRadius: 0.1,
+		MinNeighbors: 5,
+	}
+}
+
+// Returns an error describing the first option that can't be used.
+func (o ISSOptions) Validate() error {
+	if !(o.Gamma21 > 0 && o.Gamma21 <= 1) {
+		return fmt.Errorf("expected a Gamma21 in (0, 1]. got %v", o.Gamma21)
+	}
+	if !(o.Gamma32 > 0 && o.Gamma32 <= 1) {
+		return fmt.Errorf("expected a Gamma32 in (0, 1]. got %v", o.Gamma32)
+	}
+	if !(o.NonMaxRadius >= 0) || math.IsInf(o.NonMaxRadius, 1) {
+		return fmt.Errorf("expected a non-negative, finite NonMaxRadius. got %v", o.NonMaxRadius)
+	}
+	if o.MinNeighbors < 3 {
+		return fmt.Errorf("expected MinNeighbors of at least 3. got %d", o.MinNeighbors)
+	}
+	return nil
+}
+
+// Returns the columns of the analyzer's points that are Intrinsic Shape
+// Signature keypoints, in ascending order. Their neighborhoods are the same
+// ones used for descriptors, so their eigenvalues are reused by later calls to
+// Descriptor and FPFHDescriptor.
+//
+// The neighborhood of every point is calculated, using the given number of
+// goroutines as in Descriptors. Stops early and returns ctx's error if ctx is
+// done first.
+func (a *PointCloudAnalyzer) ISSKeypoints(ctx context.Context, opts ISSOptions, workers int) ([]int, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	_, c := a.universe.Dims()
+	// The smallest eigenvalue of each point that passes the ratio tests, or -1
+	// for those that don't.
+	saliency := make([]float64, c)
+	err := parallel(ctx, c, workers, func(col int) {
+		saliency[col] = -1
+		n := a.getNeighborhood(col, a.opts.Radius)
+		if len(n.members) < opts.MinNeighbors || n.collinear() {
+			return
+		}
+		e := n.Eigenvalues()
+		if e[2] <= 0 {
+			// Perfectly flat, so there's no variation to pick keypoints by.
+			return
+		}
+		if e[1]/e[0] < opts.Gamma21 && e[2]/e[1] < opts.Gamma32 {
+			saliency[col] = e[2]
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var keypoints []int
+	for col := 0; col < c; col++ {
+		if saliency[col] < 0 {
+			continue
+		}
+		max := true
+		for _, other := range a.tree.InRadius(mat64.Col(nil, col, a.universe), opts.NonMaxRadius) {
+			// Ties go to the lower column, so that exactly one of a group of
+			// equally salient points is kept.
+			if saliency[other] > saliency[col] || saliency[other] == saliency[col] && other < col {
+				max = false
+				break
+			}
+		}
+		if max {
+			keypoints = append(keypoints, col)
+		}
+	}
+	return keypoints, nil
+}
+
+// Returns one point per cube of the given size that holds any points: the one
+// nearest to the centroid of the points in the cube. This spreads keypoints
+// evenly over the cloud, however densely it was sampled. The columns are
+// returned in ascending order.
+func (a *PointCloudAnalyzer) VoxelKeypoints(size float64) ([]int, error) {
+	if err := validateVoxelSize(size); err != nil {
+		return nil, err
+	}
+	groups := voxelGroups(a.universe, size)
+	keypoints := make([]int, len(groups))
+	for i, cols := range groups {
+		keypoints[i] = nearestToCentroid(a.universe, cols)
+	}
+	sort.Ints(keypoints)
+	return keypoints, nil
+}
@@ -0,0 +1,58 @@
+package points
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gonum/matrix/mat64"
+	"golang.org/x/net/context"
+)
+
+func TestISSKeypointsPreferCornersToPlanes(t *testing.T) {
+	points := roomCorner(rand.New(rand.NewSource(4)), 3000)
+	a := &PointCloudAnalyzer{}
+	a.MakePointCloudAnalyzer(points)
+	opts := DefaultISSOptions()
+	opts.NonMaxRadius = 0.3
+	keypoints, err := a.ISSKeypoints(context.Background(), opts, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each pair of walls meets along a line through (-0.5, -0.5, 2.5) parallel
+	// to one axis. Where all three meet the neighborhood is too symmetric for
+	// its axes to be well defined, so there should be a keypoint somewhere
+	// along each line instead.
+	corner := []float64{-0.5, -0.5, 2.5}
+	edges := make(map[int]bool)
+	for _, col := range keypoints {
+		p := mat64.Col(nil, col, points)
+		var along []int
+		for i := range p {
+			if math.Abs(p[i]-corner[i]) > 0.1 {
+				along = append(along, i)
+			}
+		}
+		if len(along) > 1 {
+			t.Errorf("got keypoint %v, which isn't near where two walls meet", p)
+			continue
+		}
+		if len(along) == 1 {
+			edges[along[0]] = true
+		}
+	}
+	if len(edges) != 3 {
+		t.Errorf("got keypoints %v along %d of the lines where walls meet. want one along each of 3", keypoints, len(edges))
+	}
+
+	flat, _ := floorBehindWall()
+	a = &PointCloudAnalyzer{}
+	a.MakePointCloudAnalyzer(flat)
+	keypoints, err = a.ISSKeypoints(context.Background(), DefaultISSOptions(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keypoints) != 0 {
+		t.Errorf("got keypoints %v on flat patches. want none", keypoints)
+	}
+}
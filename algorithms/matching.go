@@ -0,0 +1,445 @@
+package points
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/gonum/matrix/mat64"
+	"golang.org/x/net/context"
+)
+
+// Returns the descriptor's histograms laid end to end as a vector of
+// DepthBuckets+AngularBuckets+Annuli values, in that order. Each histogram is
+// normalized by its number of samples so that neighborhoods of different
+// densities can be compared; each part of the vector sums to 1, or to 0 if the
+// histogram is empty. Samples in buckets outside of a histogram's range are
+// counted in the nearest bucket instead.
+//
+// Descriptors calculated with the same options always have vectors of the same
+// length.
+func (d *LFSHDescriptor) Vector() []float64 {
+	v := make([]float64, 0, d.opts.DepthBuckets+d.opts.AngularBuckets+d.opts.Annuli)
+	v = appendHistogram(v, d.LocalDepthHistogram, d.opts.DepthBuckets)
+	v = appendHistogram(v, d.NormalDevianceHistogram, d.opts.AngularBuckets)
+	v = appendHistogram(v, d.RadialDensityHistogram, d.opts.Annuli)
+	return v
+}
+
+func appendHistogram(v []float64, h map[int]int, buckets int) []float64 {
+	if buckets <= 0 {
+		return v
+	}
+	bins := make([]float64, buckets)
+	total := histogramTotal(h)
+	if total == 0 {
+		return append(v, bins...)
+	}
+	for bucket, count := range h {
+		if bucket < 0 {
+			bucket = 0
+		} else if bucket >= buckets {
+			bucket = buckets - 1
+		}
+		bins[bucket] += float64(count) / float64(total)
+	}
+	return append(v, bins...)
+}
+
+func histogramTotal(h map[int]int) int {
+	total := 0
+	for _, count := range h {
+		total += count
+	}
+	return total
+}
+
+// A way of measuring how different two descriptor vectors are. All metrics
+// give 0 for identical vectors.
+type DescriptorMetric int
+
+const (
+	// Sum of the absolute differences.
+	L1 DescriptorMetric = iota
+	// Euclidean distance.
+	L2
+	// Half the sum of (a-b)^2/(a+b) over the bins that aren't both empty.
+	ChiSquared
+	// Hellinger form of the Bhattacharyya distance, which is between 0 and 1.
+	Bhattacharyya
+)
+
+func (m DescriptorMetric) String() string {
+	switch m {
+	case L1:
+		return "L1"
+	case L2:
+		return "L2"
+	case ChiSquared:
+		return "chi-squared"
+	case Bhattacharyya:
+		return "Bhattacharyya"
+	}
+	return fmt.Sprintf("DescriptorMetric(%d)", int(m))
+}
+
+// Compares two LFSH descriptors by the Euclidean distance between their
+// vectors. Identical descriptors have a distance of 0.
+func (d *LFSHDescriptor) Distance(other *LFSHDescriptor) (float64, error) {
+	return d.MetricDistance(other, L2)
+}
+
+// Compares two LFSH descriptors' vectors with the given metric. Returns an
+// error if the descriptors were calculated with different numbers of buckets.
+func (d *LFSHDescriptor) MetricDistance(other *LFSHDescriptor, metric DescriptorMetric) (float64, error) {
+	return VectorDistance(d.Vector(), other.Vector(), metric)
+}
+
+// Compares two descriptor vectors, like those returned by
+// LFSHDescriptor.Vector, with the given metric. Returns an error if they have
+// different lengths or the metric is unknown.
+func VectorDistance(a, b []float64, metric DescriptorMetric) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("expected descriptor vectors of the same length. got %d and %d", len(a), len(b))
+	}
+	switch metric {
+	case L1:
+		sum := float64(0)
+		for i := range a {
+			sum += math.Abs(a[i] - b[i])
+		}
+		return sum, nil
+	case L2:
+		sum := float64(0)
+		for i := range a {
+			diff := a[i] - b[i]
+			sum += diff * diff
+		}
+		return math.Sqrt(sum), nil
+	case ChiSquared:
+		sum := float64(0)
+		for i := range a {
+			if total := a[i] + b[i]; total > 0 {
+				diff := a[i] - b[i]
+				sum += diff * diff / total
+			}
+		}
+		return sum / 2, nil
+	case Bhattacharyya:
+		var coefficient, aTotal, bTotal float64
+		for i := range a {
+			coefficient += math.Sqrt(a[i] * b[i])
+			aTotal += a[i]
+			bTotal += b[i]
+		}
+		if aTotal == 0 || bTotal == 0 {
+			if aTotal == bTotal {
+				return 0, nil
+			}
+			return 1, nil
+		}
+		return math.Sqrt(math.Max(0, 1-coefficient/math.Sqrt(aTotal*bTotal))), nil
+	}
+	return 0, fmt.Errorf("unknown descriptor metric: %v", metric)
+}
+
+// A pair of points, one from each of two point clouds, that appear to be the
+// same point in the scene.
+type Match struct {
+	// Columns of the points in the source and target clouds.
+	Source, Target int
+	// Distance between the points' descriptors.
+	Distance float64
+}
+
+// A kind of descriptor that points can be matched by.
+type Feature int
+
+const (
+	// PointCloudAnalyzer.Descriptor
+	LFSH Feature = iota
+	// PointCloudAnalyzer.FPFHDescriptor
+	FPFH
+)
+
+func (f Feature) String() string {
+	switch f {
+	case LFSH:
+		return "LFSH"
+	case FPFH:
+		return "FPFH"
+	}
+	return fmt.Sprintf("Feature(%d)", int(f))
+}
+
+// Calculates the vectors of the given kind of descriptor for the points in
+// cols, using every CPU. Invalid descriptors get nil vectors.
+func (a *PointCloudAnalyzer) featureVectors(feature Feature, cols []int) ([][]float64, error) {
+	vectors := make([][]float64, len(cols))
+	var err error
+	switch feature {
+	case LFSH:
+		var descriptors []LFSHDescriptor
+		descriptors, err = a.Descriptors(context.Background(), cols, 0)
+		for i := range descriptors {
+			if descriptors[i].Valid {
+				vectors[i] = descriptors[i].Vector()
+			}
+		}
+	case FPFH:
+		var descriptors []FPFHDescriptor
+		descriptors, err = a.FPFHDescriptors(context.Background(), cols, 0)
+		for i := range descriptors {
+			if descriptors[i].Valid {
+				vectors[i] = descriptors[i].Vector()
+			}
+		}
+	default:
+		err = fmt.Errorf("unknown feature: %v", feature)
+	}
+	return vectors, err
+}
+
+// Finds the target point whose LFSH descriptor is nearest to each source
+// point's. See MatchFeatures.
+func MatchDescriptors(source, target *PointCloudAnalyzer, sourceCols, targetCols []int, ratio float64) ([]Match, error) {
+	return MatchFeatures(source, target, LFSH, sourceCols, targetCols, ratio)
+}
+
+// Finds the target point whose descriptor of the given kind is nearest to each
+// source point's, by Euclidean distance. Only the given columns of each
+// analyzer are considered. If either is nil, every column of that analyzer is.
+// Points with invalid descriptors are never matched. Returns an error if the
+// analyzers' descriptors of that kind can't be compared.
+//
+// Matches are kept only if they pass Lowe's ratio test: the nearest descriptor
+// must be closer than ratio times the second nearest one. Lower ratios keep
+// fewer, but more distinctive, matches. 0.8 is a typical value.
+func MatchFeatures(source, target *PointCloudAnalyzer, feature Feature, sourceCols, targetCols []int, ratio float64) ([]Match, error) {
+	if err := comparableOptions(feature, source.Options(), target.Options()); err != nil {
+		return nil, err
+	}
+	if sourceCols == nil {
+		sourceCols = allColumns(source.universe)
+	}
+	if targetCols == nil {
+		targetCols = allColumns(target.universe)
+	}
+	targetVectors, err := target.featureVectors(feature, targetCols)
+	if err != nil {
+		return nil, err
+	}
+	sourceVectors, err := source.featureVectors(feature, sourceCols)
+	if err != nil {
+		return nil, err
+	}
+	var validTargets []int
+	for i := range targetVectors {
+		if targetVectors[i] != nil {
+			validTargets = append(validTargets, i)
+		}
+	}
+	if len(validTargets) < 2 {
+		// The ratio test needs a second nearest descriptor.
+		return nil, nil
+	}
+
+	var matches []Match
+	for k, col := range sourceCols {
+		if sourceVectors[k] == nil {
+			continue
+		}
+		best, bestDistance, secondDistance := -1, math.Inf(1), math.Inf(1)
+		for _, i := range validTargets {
+			distance, err := VectorDistance(sourceVectors[k], targetVectors[i], L2)
+			if err != nil {
+				return nil, err
+			}
+			if distance < bestDistance {
+				best, bestDistance, secondDistance = i, distance, bestDistance
+			} else if distance < secondDistance {
+				secondDistance = distance
+			}
+		}
+		if bestDistance < ratio*secondDistance {
+			matches = append(matches, Match{Source: col, Target: targetCols[best], Distance: bestDistance})
+		}
+	}
+	return matches, nil
+}
+
+// Returns an error if descriptors of the given kind calculated with options a
+// and b have vectors of different lengths. FPFH vectors are always the same
+// length.
+func comparableOptions(feature Feature, a, b LFSHOptions) error {
+	if feature != LFSH {
+		return nil
+	}
+	if a.DepthBuckets != b.DepthBuckets || a.AngularBuckets != b.AngularBuckets || a.Annuli != b.Annuli {
+		return fmt.Errorf("expected analyzers with the same LFSH buckets. got %d/%d/%d and %d/%d/%d depth/angular/annuli buckets", a.DepthBuckets, a.AngularBuckets, a.Annuli, b.DepthBuckets, b.AngularBuckets, b.Annuli)
+	}
+	return nil
+}
+
+func allColumns(m *mat64.Dense) []int {
+	_, c := m.Dims()
+	cols := make([]int, c)
+	for i := range cols {
+		cols[i] = i
+	}
+	return cols
+}
+
+type RANSACOptions struct {
+	// Number of random samples of matches to try.
+	Iterations int
+	// A match is an inlier if its source point lands within this distance of
+	// its target point once transformed.
+	InlierDistance float64
+	// Fewer inliers than this and no transform is returned. At least 3.
+	MinInliers int
+	// Source of randomness for picking samples. If nil, a fixed seed is used
+	// so that results are repeatable.
+	Rand *rand.Rand
+}
+
+// Returns options suited to coarsely aligning depth sensor frames, measured in
+// meters.
+func DefaultRANSACOptions() RANSACOptions {
+	return RANSACOptions{
+		Iterations:     1000,
+		InlierDistance: 0.05,
+		MinInliers:     3,
+	}
+}
+
+type RANSACResult struct {
+	// 4x4 homogeneous transform that maps source points onto the target.
+	Transform *mat64.Dense
+	// The matches that agree with Transform.
+	Inliers []Match
+}
+
+// Estimates the rigid transform between two point clouds from matches between
+// them, many of which may be wrong. Repeatedly fits a transform to three
+// random matches, keeps the one that the most matches agree with, and then
+// refines it using all of those matches. The result is only as precise as the
+// matched points, so it's best used to seed ICP.
+//
+// source and target are 3xN matrices with one point per column, and matches
+// refer to their columns.
+func RANSAC(source, target *mat64.Dense, matches []Match, opts RANSACOptions) (RANSACResult, error) {
+	if opts.MinInliers < 3 {
+		return RANSACResult{}, fmt.Errorf("expected MinInliers of at least 3. got %d", opts.MinInliers)
+	}
+	if len(matches) < opts.MinInliers {
+		return RANSACResult{}, fmt.Errorf("got %d matches. need at least %d", len(matches), opts.MinInliers)
+	}
+	r := opts.Rand
+	if r == nil {
+		r = rand.New(rand.NewSource(1))
+	}
+
+	var best []Match
+	for i := 0; i < opts.Iterations; i++ {
+		sample := sampleMatches(r, matches, 3)
+		if !consistentSample(source, target, sample, opts.InlierDistance) {
+			continue
+		}
+		transform, err := RigidTransform(matchedPoints(source, target, sample))
+		if err != nil {
+			continue
+		}
+		if inliers := ransacInliers(source, target, matches, transform, opts.InlierDistance); len(inliers) > len(best) {
+			best = inliers
+		}
+	}
+	if len(best) < opts.MinInliers {
+		return RANSACResult{}, errors.New("no transform agreed with enough matches")
+	}
+
+	// Refit to every inlier, and then once more in case that picked up more.
+	transform, err := RigidTransform(matchedPoints(source, target, best))
+	if err != nil {
+		return RANSACResult{}, err
+	}
+	if inliers := ransacInliers(source, target, matches, transform, opts.InlierDistance); len(inliers) >= len(best) {
+		best = inliers
+		if refit, err := RigidTransform(matchedPoints(source, target, best)); err == nil {
+			transform = refit
+		}
+	}
+	return RANSACResult{Transform: transform, Inliers: best}, nil
+}
+
+// Picks n distinct matches. There must be at least n of them. n is much smaller
+// than the number of matches, so indices are redrawn until n different ones
+// come up rather than shuffling every match.
+func sampleMatches(r *rand.Rand, matches []Match, n int) []Match {
+	sample := make([]Match, 0, n)
+	picked := make(map[int]bool, n)
+	for len(sample) < n {
+		index := r.Intn(len(matches))
+		if !picked[index] {
+			picked[index] = true
+			sample = append(sample, matches[index])
+		}
+	}
+	return sample
+}
+
+// A rigid transform preserves distances, so a sample can only be made of
+// correct matches if the distances between its source points are about the
+// same as those between its target points. Checking this first skips fitting
+// most bad samples. Samples whose points are too close together to define a
+// rotation are rejected too.
+func consistentSample(source, target *mat64.Dense, sample []Match, tolerance float64) bool {
+	for i := range sample {
+		for j := i + 1; j < len(sample); j++ {
+			s := columnDistance(source, sample[i].Source, sample[j].Source)
+			t := columnDistance(target, sample[i].Target, sample[j].Target)
+			if math.Abs(s-t) > 2*tolerance || s < tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func columnDistance(m *mat64.Dense, a, b int) float64 {
+	dx, dy, dz := m.At(0, a)-m.At(0, b), m.At(1, a)-m.At(1, b), m.At(2, a)-m.At(2, b)
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+// Returns the matches whose source point lands within maxDistance of its
+// target point once transformed.
+func ransacInliers(source, target *mat64.Dense, matches []Match, transform *mat64.Dense, maxDistance float64) []Match {
+	var inliers []Match
+	for _, m := range matches {
+		x, y, z := source.At(0, m.Source), source.At(1, m.Source), source.At(2, m.Source)
+		squared := float64(0)
+		for i := 0; i < 3; i++ {
+			d := transform.At(i, 0)*x + transform.At(i, 1)*y + transform.At(i, 2)*z + transform.At(i, 3) - target.At(i, m.Target)
+			squared += d * d
+		}
+		if squared <= maxDistance*maxDistance {
+			inliers = append(inliers, m)
+		}
+	}
+	return inliers
+}
+
+// Returns the matched source and target points as 3xN matrices whose columns
+// correspond.
+func matchedPoints(source, target *mat64.Dense, matches []Match) (*mat64.Dense, *mat64.Dense) {
+	from := mat64.NewDense(3, len(matches), nil)
+	to := mat64.NewDense(3, len(matches), nil)
+	for k, m := range matches {
+		for i := 0; i < 3; i++ {
+			from.Set(i, k, source.At(i, m.Source))
+			to.Set(i, k, target.At(i, m.Target))
+		}
+	}
+	return from, to
+}
@@ -0,0 +1,120 @@
+package points
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// Returns n random points on a bumpy 40cm square surface a meter away. Its
+// bumps give most points distinctive descriptors.
+func bumpySurface(r *rand.Rand, n int) *mat64.Dense {
+	m := mat64.NewDense(3, n, nil)
+	for j := 0; j < n; j++ {
+		x, y := r.Float64()*0.4-0.2, r.Float64()*0.4-0.2
+		m.SetCol(j, []float64{x, y, 1 + 0.1*math.Sin(20*x)*math.Cos(13*y) + x*y})
+	}
+	return m
+}
+
+func TestMatchFeaturesRatioTest(t *testing.T) {
+	points := bumpySurface(rand.New(rand.NewSource(2)), 400)
+	_, n := points.Dims()
+	// Every point twice, so each target descriptor has an identical twin.
+	doubled := mat64.NewDense(3, 2*n, nil)
+	for j := 0; j < n; j++ {
+		doubled.SetCol(j, mat64.Col(nil, j, points))
+		doubled.SetCol(n+j, mat64.Col(nil, j, points))
+	}
+	source, target, twins := &PointCloudAnalyzer{}, &PointCloudAnalyzer{}, &PointCloudAnalyzer{}
+	source.MakePointCloudAnalyzer(points)
+	target.MakePointCloudAnalyzer(points)
+	twins.MakePointCloudAnalyzer(doubled)
+	cols := []int{1, 2, 3, 50, 100, 200, 300}
+
+	for _, feature := range []Feature{LFSH, FPFH} {
+		matches, err := MatchFeatures(source, target, feature, cols, nil, 0.8)
+		if err != nil {
+			t.Errorf("%v: %v", feature, err)
+			continue
+		}
+		if len(matches) == 0 {
+			t.Errorf("%v: matched none of %v with themselves", feature, cols)
+		}
+		for _, m := range matches {
+			if m.Source != m.Target || m.Distance != 0 {
+				t.Errorf("%v: got %+v. want each point matched with itself", feature, m)
+			}
+		}
+
+		// The nearest two descriptors are always equally near, so no match is
+		// distinctive enough to keep.
+		matches, err = MatchFeatures(source, twins, feature, cols, nil, 0.99)
+		if err != nil {
+			t.Errorf("%v: %v", feature, err)
+			continue
+		}
+		if len(matches) != 0 {
+			t.Errorf("%v: got %v against a cloud of twins. want no matches", feature, matches)
+		}
+	}
+}
+
+func TestMatchDescriptorsDifferentBuckets(t *testing.T) {
+	points := bumpySurface(rand.New(rand.NewSource(2)), 100)
+	a := &PointCloudAnalyzer{}
+	a.MakePointCloudAnalyzer(points)
+	opts := DefaultLFSHOptions()
+	opts.DepthBuckets++
+	b := &PointCloudAnalyzer{}
+	if err := b.MakePointCloudAnalyzerWithOptions(points, opts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MatchDescriptors(a, b, nil, nil, 0.8); err == nil {
+		t.Error("got no error matching LFSH descriptors with different depth buckets")
+	}
+	// FPFH descriptors don't depend on the LFSH buckets.
+	if _, err := MatchFeatures(a, b, FPFH, []int{1, 2}, nil, 0.8); err != nil {
+		t.Errorf("matching FPFH descriptors: %v", err)
+	}
+}
+
+func TestRANSACWithOutliers(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	source := roomCorner(r, 600)
+	_, n := source.Dims()
+	want := yawTransform(0.7, 0.3, -0.2, 0.5)
+	target := TransformPoints(want, source)
+
+	// 40% of the matches pair points with random ones.
+	var matches []Match
+	for i := 0; i < 200; i++ {
+		col := r.Intn(n)
+		if i%5 < 2 {
+			matches = append(matches, Match{Source: col, Target: r.Intn(n)})
+		} else {
+			matches = append(matches, Match{Source: col, Target: col})
+		}
+	}
+	opts := DefaultRANSACOptions()
+	result, err := RANSAC(source, target, matches, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mat64.EqualApprox(result.Transform, want, 1e-6) {
+		t.Errorf("got transform\n%v\nwant\n%v", mat64.Formatted(result.Transform), mat64.Formatted(want))
+	}
+	// Some random matches may happen to agree with the transform, so the
+	// inliers are compared with those that do rather than with the correct
+	// matches.
+	agreeing := ransacInliers(source, target, matches, want, opts.InlierDistance)
+	if len(result.Inliers) != len(agreeing) {
+		t.Errorf("got %d inliers. want %d", len(result.Inliers), len(agreeing))
+	}
+
+	if _, err := RANSAC(source, target, matches[:2], opts); err == nil {
+		t.Error("got no error from 2 matches")
+	}
+}
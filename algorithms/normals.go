@@ -0,0 +1,155 @@
+package points
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// A way of picking which way normals point. Each neighborhood only defines the
+// line its normal lies on, so without orienting them, neighboring normals on
+// a flat surface can point in opposite directions.
+type NormalOrientation int
+
+const (
+	// Each normal points toward LFSHOptions.Viewpoint. This is cheap and
+	// correct for clouds seen from a single viewpoint, like a depth frame.
+	OrientTowardViewpoint NormalOrientation = iota
+	// Orientation is propagated from point to nearby point along a minimum
+	// spanning tree, preferring to propagate between points whose normals are
+	// nearly parallel, as described in:
+	// https://doi.org/10.1145/133994.134011
+	// The point of each connected part of the cloud that is nearest to
+	// LFSHOptions.Viewpoint is oriented toward it first. Points with
+	// degenerate neighborhoods are oriented toward the viewpoint too, and
+	// orientation isn't propagated through them. This suits clouds merged
+	// from many viewpoints, but costs a pass over the whole cloud the first
+	// time a normal is needed.
+	OrientByPropagation
+)
+
+func (o NormalOrientation) String() string {
+	switch o {
+	case OrientTowardViewpoint:
+		return "toward viewpoint"
+	case OrientByPropagation:
+		return "by propagation"
+	}
+	return fmt.Sprintf("NormalOrientation(%d)", int(o))
+}
+
+// Number of nearest points that each point's orientation can be propagated to.
+const propagationNeighbors = 8
+
+// Returns a direction that the normal of the point in the given column should
+// be within 90 degrees of.
+func (a *PointCloudAnalyzer) orientationReference(col int) []float64 {
+	switch a.opts.Orientation {
+	case OrientByPropagation:
+		a.propagateOnce.Do(a.propagateNormals)
+		return a.propagated[col][:]
+	default:
+		return a.orientationTowardViewpoint(col)
+	}
+}
+
+// Orients the normal of every point's neighborhood with Prim's algorithm,
+// using 1-|cos| of the angle between normals as the cost of propagating
+// between nearby points.
+//
+// Points with degenerate neighborhoods have meaningless normals, which could
+// flip whatever they're propagated to, so they're left out of the tree. Their
+// normals are oriented toward the viewpoint, and a point whose only path to
+// the rest of the tree is through them is seeded from the viewpoint too.
+func (a *PointCloudAnalyzer) propagateNormals() {
+	_, c := a.universe.Dims()
+	a.propagated = make([][3]float64, c)
+	visited := make([]bool, c)
+	parallelAll(c, func(col int) {
+		n := a.getNeighborhood(col, a.opts.Radius)
+		normal := n.unorientedNormal()
+		copy(a.propagated[col][:], mat64.Col(nil, 0, &normal))
+		if n.Degenerate() {
+			a.orientToward(col, a.orientationTowardViewpoint(col))
+			visited[col] = true
+		}
+	})
+
+	// Seeds are tried from nearest to farthest from the viewpoint, so each
+	// connected part starts from its nearest point.
+	seeds := allColumns(a.universe)
+	viewpoint := a.opts.Viewpoint[:]
+	distances := make([]float64, c)
+	for col := range distances {
+		distances[col] = a.tree.squaredDistance(col, viewpoint)
+	}
+	sort.Slice(seeds, func(i, j int) bool { return distances[seeds[i]] < distances[seeds[j]] })
+
+	for _, seed := range seeds {
+		if visited[seed] {
+			continue
+		}
+		a.orientToward(seed, a.orientationTowardViewpoint(seed))
+		visited[seed] = true
+		edges := &propagationHeap{}
+		a.pushPropagations(edges, seed, visited)
+		for edges.Len() > 0 {
+			e := heap.Pop(edges).(propagation)
+			if visited[e.to] {
+				continue
+			}
+			a.orientToward(e.to, a.propagated[e.from][:])
+			visited[e.to] = true
+			a.pushPropagations(edges, e.to, visited)
+		}
+	}
+}
+
+func (a *PointCloudAnalyzer) orientationTowardViewpoint(col int) []float64 {
+	point := mat64.Col(nil, col, a.universe)
+	v := a.opts.Viewpoint
+	return []float64{v[0] - point[0], v[1] - point[1], v[2] - point[2]}
+}
+
+// Flips the propagated normal of col if it points away from reference.
+func (a *PointCloudAnalyzer) orientToward(col int, reference []float64) {
+	n := &a.propagated[col]
+	if dot3(n[:], reference) < 0 {
+		n[0], n[1], n[2] = -n[0], -n[1], -n[2]
+	}
+}
+
+func (a *PointCloudAnalyzer) pushPropagations(edges *propagationHeap, from int, visited []bool) {
+	point := mat64.Col(nil, from, a.universe)
+	// One extra, since the point itself is the nearest.
+	for _, to := range a.tree.KNearest(point, propagationNeighbors+1) {
+		// Including the points with degenerate neighborhoods.
+		if visited[to] {
+			continue
+		}
+		cost := 1 - math.Abs(dot3(a.propagated[from][:], a.propagated[to][:]))
+		heap.Push(edges, propagation{from: from, to: to, cost: cost})
+	}
+}
+
+type propagation struct {
+	from, to int
+	cost     float64
+}
+
+// A min heap of propagations, so the cheapest one is at the top.
+type propagationHeap []propagation
+
+func (h propagationHeap) Len() int            { return len(h) }
+func (h propagationHeap) Less(i, j int) bool  { return h[i].cost < h[j].cost }
+func (h propagationHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *propagationHeap) Push(x interface{}) { *h = append(*h, x.(propagation)) }
+func (h *propagationHeap) Pop() interface{} {
+	old := *h
+	p := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return p
+}
@@ -0,0 +1,49 @@
+package points
+
+import (
+	"testing"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// Two patches of floor a meter below the viewpoint, joined by a line of points
+// too far apart to have any neighbors. The points on the line are nearest to
+// the viewpoint, and their normals are meaningless.
+func patchesJoinedByStragglers() *mat64.Dense {
+	var cols [][]float64
+	for _, x := range []float64{-0.7, 0.5} {
+		for i := 0; i <= 10; i++ {
+			for j := -5; j <= 5; j++ {
+				cols = append(cols, []float64{x + float64(i)*0.02, float64(j) * 0.02, 1})
+			}
+		}
+	}
+	for x := -0.35; x < 0.4; x += 0.15 {
+		cols = append(cols, []float64{x, 0, 1})
+	}
+	m := mat64.NewDense(3, len(cols), nil)
+	for j, col := range cols {
+		m.SetCol(j, col)
+	}
+	return m
+}
+
+func TestPropagationSkipsDegenerateNeighborhoods(t *testing.T) {
+	points := patchesJoinedByStragglers()
+	opts := DefaultLFSHOptions()
+	opts.Orientation = OrientByPropagation
+	a := &PointCloudAnalyzer{}
+	if err := a.MakePointCloudAnalyzerWithOptions(points, opts); err != nil {
+		t.Fatal(err)
+	}
+	_, c := points.Dims()
+	for col := 0; col < c; col++ {
+		n := a.getNeighborhood(col, opts.Radius)
+		if n.Degenerate() {
+			continue
+		}
+		if normal := unitNormal(n); normal[2] > 0 {
+			t.Errorf("got normal %v at %v. want one pointing toward the viewpoint", normal, mat64.Col(nil, col, points))
+		}
+	}
+}
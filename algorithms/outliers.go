@@ -0,0 +1,83 @@
+package points
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// Statistical outlier removal: finds the mean distance from each point to its
+// k nearest neighbors, and returns the columns of the points whose mean
+// distance is no more than stdDevMultiplier standard deviations above the mean
+// of those distances over the whole cloud, in ascending order. Isolated points,
+// like the flying pixels a depth sensor reports at the edges of objects, have
+// unusually distant neighbors.
+func (a *PointCloudAnalyzer) StatisticalOutlierRemoval(k int, stdDevMultiplier float64) ([]int, error) {
+	if k <= 0 {
+		return nil, fmt.Errorf("expected a positive number of neighbors. got %d", k)
+	}
+	if math.IsNaN(stdDevMultiplier) || math.IsInf(stdDevMultiplier, 0) {
+		return nil, fmt.Errorf("expected a finite standard deviation multiplier. got %v", stdDevMultiplier)
+	}
+	_, c := a.universe.Dims()
+	if c == 0 {
+		return nil, nil
+	}
+	meanDistances := make([]float64, c)
+	parallelAll(c, func(col int) {
+		point := mat64.Col(nil, col, a.universe)
+		// One extra, since the point itself is the nearest.
+		neighbors := a.tree.KNearest(point, k+1)
+		total, count := float64(0), 0
+		for _, other := range neighbors {
+			if other == col {
+				continue
+			}
+			total += math.Sqrt(a.tree.squaredDistance(other, point))
+			count++
+		}
+		if count > 0 {
+			meanDistances[col] = total / float64(count)
+		}
+	})
+
+	mean := average(meanDistances)
+	variance := float64(0)
+	for _, d := range meanDistances {
+		variance += (d - mean) * (d - mean) / float64(c)
+	}
+	threshold := mean + stdDevMultiplier*math.Sqrt(variance)
+	var inliers []int
+	for col, d := range meanDistances {
+		if d <= threshold {
+			inliers = append(inliers, col)
+		}
+	}
+	return inliers, nil
+}
+
+// Radius outlier removal: returns the columns of the points that have at least
+// minNeighbors other points within radius of them, in ascending order. Speckle
+// noise from a depth sensor is made of points with few neighbors.
+func (a *PointCloudAnalyzer) RadiusOutlierRemoval(radius float64, minNeighbors int) ([]int, error) {
+	if !(radius > 0) || math.IsInf(radius, 1) {
+		return nil, fmt.Errorf("expected a positive, finite radius. got %v", radius)
+	}
+	if minNeighbors < 0 {
+		return nil, fmt.Errorf("expected a non-negative number of neighbors. got %d", minNeighbors)
+	}
+	_, c := a.universe.Dims()
+	keep := make([]bool, c)
+	parallelAll(c, func(col int) {
+		// The point itself is always within the radius.
+		keep[col] = len(a.tree.InRadius(mat64.Col(nil, col, a.universe), radius))-1 >= minNeighbors
+	})
+	var inliers []int
+	for col := range keep {
+		if keep[col] {
+			inliers = append(inliers, col)
+		}
+	}
+	return inliers, nil
+}
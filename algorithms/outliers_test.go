@@ -0,0 +1,54 @@
+package points
+
+import (
+	"testing"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+func TestOutlierRemovalDropsIsolatedPoint(t *testing.T) {
+	patches, _ := floorBehindWall()
+	_, c := patches.Dims()
+	// Half a meter above the middle of the floor, with nothing near it.
+	points := mat64.NewDense(3, c+1, nil)
+	points.Copy(patches)
+	points.SetCol(c, []float64{0, 0, 0.5})
+	a := &PointCloudAnalyzer{}
+	a.MakePointCloudAnalyzer(points)
+
+	for _, tc := range []struct {
+		filter string
+		remove func() ([]int, error)
+	}{
+		{"statistical", func() ([]int, error) { return a.StatisticalOutlierRemoval(8, 3) }},
+		{"radius", func() ([]int, error) { return a.RadiusOutlierRemoval(0.05, 3) }},
+	} {
+		kept, err := tc.remove()
+		if err != nil {
+			t.Errorf("%s: %v", tc.filter, err)
+			continue
+		}
+		if len(kept) != c {
+			t.Errorf("%s: kept %d points. want all but the isolated one: %d", tc.filter, len(kept), c)
+			continue
+		}
+		for i, col := range kept {
+			if col != i {
+				t.Errorf("%s: kept column %d at index %d. want columns 0 to %d in order", tc.filter, col, i, c-1)
+				break
+			}
+		}
+	}
+}
+
+func TestOutlierRemovalInvalidArguments(t *testing.T) {
+	points, _ := floorBehindWall()
+	a := &PointCloudAnalyzer{}
+	a.MakePointCloudAnalyzer(points)
+	if _, err := a.StatisticalOutlierRemoval(0, 1); err == nil {
+		t.Error("got no error for a k of 0")
+	}
+	if _, err := a.RadiusOutlierRemoval(0, 3); err == nil {
+		t.Error("got no error for a radius of 0")
+	}
+}
@@ -3,10 +3,14 @@
 package points
 
 import (
+	"fmt"
 	"github.com/gonum/matrix/mat64"
+	"golang.org/x/net/context"
 	"image/color"
-	"log"
 	"math"
+	"runtime"
+	"sort"
+	"sync"
 )
 
 type plane struct {
@@ -21,9 +25,27 @@ type neighborhood struct {
 	Center mat64.Vector
 	R      float64
 	// Private
-	normal   *mat64.Vector
-	plane    *plane
-	universe *PointCloudAnalyzer
+	// Column of the universe that the neighborhood is centered on.
+	col int
+	// Everything below is computed at most once, even if the neighborhood is
+	// shared between goroutines.
+	eigenOnce sync.Once
+	// Unit eigenvector of the covariance matrix with the smallest eigenvalue,
+	// whose sign is arbitrary.
+	unoriented mat64.Vector
+	// Eigenvalues of the covariance matrix, from largest to smallest.
+	eigenvalues []float64
+	normalOnce  sync.Once
+	normal      *mat64.Vector
+	planeOnce   sync.Once
+	plane       *plane
+	spfhOnce    sync.Once
+	spfh        []float64
+	universe    *PointCloudAnalyzer
+	// Columns of the universe in the neighborhood, in ascending order. The
+	// neighborhood's own column j holds the point from universe column
+	// members[j].
+	members []int
 }
 
 // https://www.researchgate.net/publication/293330421_A_fast_and_robust_local_descriptor_for_3D_point_cloud_registration
@@ -31,28 +53,209 @@ type LFSHDescriptor struct {
 	LocalDepthHistogram     map[int]int
 	NormalDevianceHistogram map[int]int
 	RadialDensityHistogram  map[int]int
+	// False if the point's neighborhood is degenerate, in which case the
+	// histograms are empty. See LFSHOptions.MinNeighbors.
+	Valid bool
+	// Options the descriptor was calculated with.
+	opts LFSHOptions
 }
 
+// Parameters of the LFSH descriptor. Descriptors are only comparable if they
+// were calculated with the same options.
+type LFSHOptions struct {
+	// Radius of the neighborhood sphere around each point, in whatever units
+	// the coordinate system is in.
+	Radius float64
+	// Number of buckets for the Local Depth Histogram (N1 in the paper linked
+	// above).
+	DepthBuckets int
+	// Number of buckets for the Deviance Angle Histogram (N2 in the paper
+	// linked above).
+	AngularBuckets int
+	// Number of buckets for the RadialDensityHistogram (N3 in the paper linked
+	// above).
+	Annuli int
+	// How to pick which of the two directions perpendicular to a neighborhood
+	// its normal points in.
+	Orientation NormalOrientation
+	// Location, in the cloud's coordinates, that normals are oriented toward.
+	// For a single depth frame in camera space this is the origin, where the
+	// sensor is. See NormalOrientation.
+	Viewpoint [3]float64
+	// Neighborhoods with fewer points than this, or whose points are nearly
+	// collinear, don't define a plane, so they're degenerate: their points get
+	// invalid descriptors and their normals are left out of other points'
+	// descriptors. At least 3.
+	MinNeighbors int
+}
+
+// Returns the options suggested by the paper, for clouds measured in meters.
+func DefaultLFSHOptions() LFSHOptions {
+	return LFSHOptions{
+		Radius:         0.1,
+		DepthBuckets:   10,
+		AngularBuckets: 15,
+		Annuli:         5,
+		MinNeighbors:   5,
+	}
+}
+
+// Returns an error describing the first option that can't be used.
+func (o LFSHOptions) Validate() error {
+	if !(o.Radius > 0) || math.IsInf(o.Radius, 1) {
+		return fmt.Errorf("expected a positive, finite Radius. got %v", o.Radius)
+	}
+	if o.DepthBuckets <= 0 {
+		return fmt.Errorf("expected a positive DepthBuckets. got %d", o.DepthBuckets)
+	}
+	if o.AngularBuckets <= 0 {
+		return fmt.Errorf("expected a positive AngularBuckets. got %d", o.AngularBuckets)
+	}
+	if o.Annuli <= 0 {
+		return fmt.Errorf("expected a positive Annuli. got %d", o.Annuli)
+	}
+	if o.MinNeighbors < 3 {
+		return fmt.Errorf("expected MinNeighbors of at least 3. got %d", o.MinNeighbors)
+	}
+	if o.Orientation != OrientTowardViewpoint && o.Orientation != OrientByPropagation {
+		return fmt.Errorf("unknown normal orientation: %v", o.Orientation)
+	}
+	for _, v := range o.Viewpoint {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("expected a finite Viewpoint. got %v", o.Viewpoint)
+		}
+	}
+	return nil
+}
+
+// A PointCloudAnalyzer is safe to use from multiple goroutines.
 type PointCloudAnalyzer struct {
 	universe *mat64.Dense
-	// Mapping of point in universe to precalculated neighborhood. Key is the
-	// index of the point in the universe (which column the point is at).
-	neighborhoods map[int]neighborhood
+	opts     LFSHOptions
+	// Guards neighborhoods.
+	mu sync.Mutex
+	// Mapping of point in universe, and the radius around it, to precalculated
+	// neighborhood.
+	neighborhoods map[neighborhoodKey]*neighborhood
+	// Spatial index of the universe, used to find neighborhoods.
+	tree *KDTree
+	// Normals of every point, oriented consistently with each other, for
+	// OrientByPropagation.
+	propagateOnce sync.Once
+	propagated    [][3]float64
+}
+
+type neighborhoodKey struct {
+	// Index of the point in the universe (which column the point is at).
+	col    int
+	radius float64
 }
 
+// Prepares the analyzer to describe the given points, using
+// DefaultLFSHOptions.
 func (a *PointCloudAnalyzer) MakePointCloudAnalyzer(points *mat64.Dense) {
+	// The default options are always valid.
+	a.MakePointCloudAnalyzerWithOptions(points, DefaultLFSHOptions())
+}
+
+// Prepares the analyzer to describe the given points with the given options.
+// Returns an error, and leaves the analyzer unchanged, if the options are
+// invalid.
+func (a *PointCloudAnalyzer) MakePointCloudAnalyzerWithOptions(points *mat64.Dense, opts LFSHOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
 	a.universe = points
-	a.neighborhoods = make(map[int]neighborhood)
+	a.opts = opts
+	a.neighborhoods = make(map[neighborhoodKey]*neighborhood)
+	a.tree = NewKDTree(points)
+	return nil
 }
 
-// Calculates and returns the point's LFSH descriptor.
+// Returns the options that descriptors are calculated with.
+func (a *PointCloudAnalyzer) Options() LFSHOptions {
+	return a.opts
+}
+
+// Calculates and returns the point's LFSH descriptor. Check its Valid field
+// before using it.
 func (a *PointCloudAnalyzer) Descriptor(col int) LFSHDescriptor {
-	n := a.getNeighborhood(col, searchRadius)
+	n := a.getNeighborhood(col, a.opts.Radius)
+	if n.Degenerate() {
+		return LFSHDescriptor{
+			LocalDepthHistogram:     map[int]int{},
+			NormalDevianceHistogram: map[int]int{},
+			RadialDensityHistogram:  map[int]int{},
+			opts:                    a.opts,
+		}
+	}
 	return LFSHDescriptor{
 		LocalDepthHistogram:     n.LocalDepthHistogram(),
 		NormalDevianceHistogram: n.NormalDevianceHistogram(),
 		RadialDensityHistogram:  n.RadialDensityHistogram(),
+		Valid:                   true,
+		opts:                    a.opts,
+	}
+}
+
+// Calculates the LFSH descriptors of the points in the given columns, or of
+// every point if cols is nil, using the given number of goroutines. If workers
+// isn't positive, one goroutine per CPU is used. The descriptor of cols[i] is
+// at index i of the result.
+//
+// Stops early and returns ctx's error if ctx is done before every descriptor
+// has been calculated.
+func (a *PointCloudAnalyzer) Descriptors(ctx context.Context, cols []int, workers int) ([]LFSHDescriptor, error) {
+	if cols == nil {
+		cols = allColumns(a.universe)
 	}
+	descriptors := make([]LFSHDescriptor, len(cols))
+	err := parallel(ctx, len(cols), workers, func(i int) {
+		descriptors[i] = a.Descriptor(cols[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return descriptors, nil
+}
+
+// Calls f with each of 0 to n-1 from the given number of goroutines, and waits
+// for them to return. Stops early and returns ctx's error if ctx is done first.
+func parallel(ctx context.Context, n, workers int, f func(i int)) error {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				f(i)
+			}
+		}()
+	}
+
+	var err error
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case indices <- i:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break feed
+		}
+	}
+	close(indices)
+	wg.Wait()
+	return err
+}
+
+// Calls f with each of 0 to n-1 using every CPU, and waits for them to return.
+func parallelAll(n int, f func(i int)) {
+	// A background context is never done, so parallel can't fail.
+	_ = parallel(context.Background(), n, 0, f)
 }
 
 // Visualizes an LFSH descriptor's three maps using color. The
@@ -62,15 +265,15 @@ func (a *PointCloudAnalyzer) Descriptor(col int) LFSHDescriptor {
 func (d *LFSHDescriptor) VisualizeDescriptor() color.RGBA {
 	var red_avg float32 = 0.0
 	for key, value := range d.LocalDepthHistogram {
-		red_avg += float32(key) / float32(numberDepthBuckets) * float32(value)
+		red_avg += float32(key) / float32(d.opts.DepthBuckets) * float32(value)
 	}
 	var green_avg float32 = 0.0
 	for key, value := range d.NormalDevianceHistogram {
-		green_avg += float32(key) / float32(numberAngularBuckets) * float32(value)
+		green_avg += float32(key) / float32(d.opts.AngularBuckets) * float32(value)
 	}
 	var blue_avg float32 = 0.0
 	for key, value := range d.RadialDensityHistogram {
-		blue_avg += float32(key) / float32(numberAnnuli) * float32(value)
+		blue_avg += float32(key) / float32(d.opts.Annuli) * float32(value)
 	}
 	return color.RGBA{
 		R: uint8(red_avg * 255),
@@ -80,24 +283,7 @@ func (d *LFSHDescriptor) VisualizeDescriptor() color.RGBA {
 	}
 }
 
-const (
-	// Number of buckets for the Local Depth Histogram (N1 in the paper linked
-	// above).
-	numberDepthBuckets = 10
-
-	// Number of buckets for the Deviance Angle Histogram (N2 in the paper linked
-	// above).
-	numberAngularBuckets = 15
-
-	// Number of buckets for the RadialDensityHistogram (N3 in the paper linked
-	// above).
-	numberAnnuli  = 5
-	frobeniusNorm = 2
-
-	// This value is used to set the size of the neighborhood sphere. In whatever
-	// units the coordinate system is in.
-	searchRadius = 0.1
-)
+const frobeniusNorm = 2
 
 // n = normal
 // x = point in space (finding distance between this and plane p)
@@ -111,58 +297,55 @@ func (p *plane) distanceToPoint(a mat64.Vector) float64 {
 	return mat64.Dot(&a, &p.UnitNormal) - mat64.Dot(&p.UnitNormal, &p.Center)
 }
 
-func magnitudeSquared(v *mat64.Vector) float64 {
-	sum := float64(0)
-	r, _ := v.Dims()
-	for i := 0; i < r; i++ {
-		elem := v.At(i, 0)
-		sum += elem * elem
+func (a *PointCloudAnalyzer) getNeighborhood(col int, radius float64) *neighborhood {
+	key := neighborhoodKey{col: col, radius: radius}
+	a.mu.Lock()
+	n, ok := a.neighborhoods[key]
+	a.mu.Unlock()
+	if ok {
+		return n
 	}
-	return sum
-}
-
-func (a *PointCloudAnalyzer) getNeighborhood(col int, radius float64) neighborhood {
-	n, ok := a.neighborhoods[col]
-	if !ok {
-		n = a.implGetNeighborhood(col, searchRadius)
-		a.neighborhoods[col] = n
+	// Built without holding the lock so that other goroutines aren't blocked
+	// meanwhile. If another goroutine builds the same neighborhood first, its
+	// copy is used instead so that cached normals are shared.
+	n = a.implGetNeighborhood(col, radius)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if existing, ok := a.neighborhoods[key]; ok {
+		return existing
 	}
+	a.neighborhoods[key] = n
 	return n
 }
 
-func (a *PointCloudAnalyzer) implGetNeighborhood(col int, radius float64) neighborhood {
+func (a *PointCloudAnalyzer) implGetNeighborhood(col int, radius float64) *neighborhood {
 	points := a.universe
-	_, c := points.Dims()
 	point := points.ColView(col)
+	members := a.tree.InRadius(mat64.Col(nil, col, points), radius)
+	// Sorted so that the neighborhood, and everything computed from it, is
+	// the same every time.
+	sort.Ints(members)
 	neighborhood := &neighborhood{
 		Dense:    mat64.NewDense(3, 0, nil),
 		Center:   *point,
 		R:        radius,
+		col:      col,
 		universe: a,
+		members:  members,
 	}
-	diff := mat64.NewVector(3, []float64{0, 0, 0})
-	neighborhoodSet := make(map[int]bool)
-	for j := 0; j < c; j++ {
-		column := points.ColView(j)
-		diff.SubVec(column, point)
-		distanceSquared := magnitudeSquared(diff)
-		if distanceSquared <= radius*radius {
-			neighborhoodSet[j] = true
-		}
-	}
-	log.Println(len(neighborhoodSet))
-	neighborhood.Dense = neighborhood.Grow(0, len(neighborhoodSet)).(*mat64.Dense)
-	index := 0
-	for k := range neighborhoodSet {
-		*neighborhood.ColView(index) = *points.ColView(k)
-		index++
+	neighborhood.Dense = neighborhood.Grow(0, len(members)).(*mat64.Dense)
+	for index, k := range members {
+		neighborhood.SetCol(index, mat64.Col(nil, k, points))
 	}
-	return *neighborhood
+	return neighborhood
 }
 
+// Returns v scaled to a length of 1, or the zero vector if v has no length.
 func unit(v mat64.Vector) mat64.Vector {
 	unitVector := *mat64.NewVector(3, []float64{0, 0, 0})
-	unitVector.ScaleVec(1/mat64.Norm(&v, frobeniusNorm), &v)
+	if norm := mat64.Norm(&v, frobeniusNorm); norm > 0 {
+		unitVector.ScaleVec(1/norm, &v)
+	}
 	return unitVector
 }
 
@@ -176,47 +359,59 @@ func unit(v mat64.Vector) mat64.Vector {
 // The plane's "center" is guaranteed to be the center of the neighborhood
 // sphere projected onto the plane.
 func (n *neighborhood) Plane() plane {
-	if n.plane != nil {
-		return *n.plane
-	}
-
-	unitNormal := unit(n.Normal())
-	center := *mat64.NewVector(3, []float64{0, 0, 0})
-	center.AddScaledVec(&n.Center, n.R, &unitNormal)
-	n.plane = &plane{
-		UnitNormal: unitNormal,
-		Center:     center,
-	}
+	n.planeOnce.Do(func() {
+		unitNormal := unit(n.Normal())
+		center := *mat64.NewVector(3, []float64{0, 0, 0})
+		center.AddScaledVec(&n.Center, n.R, &unitNormal)
+		n.plane = &plane{
+			UnitNormal: unitNormal,
+			Center:     center,
+		}
+	})
 	return *n.plane
 }
 
+// Counts the points by their depth below the plane, which is between 0 and
+// 2*R, so buckets run from 0 to DepthBuckets-1.
 func (n *neighborhood) LocalDepthHistogram() map[int]int {
 	histogram := make(map[int]int)
 	projectionPlane := n.Plane()
 	_, c := n.Dims()
 	for j := 0; j < c; j++ {
 		point := n.ColView(j)
-		dist := projectionPlane.distanceToPoint(*point)
-		bucket := int(math.Floor(dist / ((2 * n.R) / numberDepthBuckets)))
+		// The plane's normal points away from the neighborhood, so points
+		// are at negative distances.
+		depth := -projectionPlane.distanceToPoint(*point)
+		bucket := int(math.Floor(depth / ((2 * n.R) / float64(n.universe.opts.DepthBuckets))))
 		histogram[bucket]++
 	}
 	return histogram
 }
 
+// Counts the points by the angle between their normal and the center's, which
+// is between 0 and Pi, so buckets run from 0 to AngularBuckets-1.
 func (n *neighborhood) NormalDevianceHistogram() map[int]int {
 	histogram := make(map[int]int)
 	_, c := n.Dims()
 	unitNormal := unit(n.Normal())
 	for j := 0; j < c; j++ {
-		otherNeighborhood := n.universe.getNeighborhood(j, n.R)
+		otherNeighborhood := n.universe.getNeighborhood(n.members[j], n.R)
+		if otherNeighborhood.Degenerate() {
+			// Its normal is meaningless.
+			continue
+		}
 		otherUnitNormal := unit(otherNeighborhood.Normal())
-		deviance := math.Acos(mat64.Dot(&unitNormal, &otherUnitNormal))
-		bucket := int(math.Floor(deviance / ((math.Pi) / numberAngularBuckets)))
+		// Rounding can put the dot product of unit vectors just outside of
+		// Acos's domain.
+		deviance := math.Acos(math.Max(-1, math.Min(1, mat64.Dot(&unitNormal, &otherUnitNormal))))
+		bucket := int(math.Floor(deviance / ((math.Pi) / float64(n.universe.opts.AngularBuckets))))
 		histogram[bucket]++
 	}
 	return histogram
 }
 
+// Counts the points by their distance from the center once projected onto the
+// plane, which is between 0 and R, so buckets run from 0 to Annuli-1.
 func (n *neighborhood) RadialDensityHistogram() map[int]int {
 	histogram := make(map[int]int)
 	projectionPlane := n.Plane()
@@ -225,11 +420,11 @@ func (n *neighborhood) RadialDensityHistogram() map[int]int {
 		point := n.ColView(j)
 		dist := projectionPlane.distanceToPoint(*point)
 		projectedPoint := *mat64.NewVector(3, []float64{0, 0, 0})
-		projectedPoint.AddScaledVec(point, dist, &projectionPlane.UnitNormal)
+		projectedPoint.AddScaledVec(point, -dist, &projectionPlane.UnitNormal)
 		displacement := *mat64.NewVector(3, []float64{0, 0, 0})
 		displacement.SubVec(&projectionPlane.Center, &projectedPoint)
 		projectedDistance := mat64.Norm(&displacement, frobeniusNorm)
-		annulus := int(math.Floor(projectedDistance / (n.R / numberAnnuli)))
+		annulus := int(math.Floor(projectedDistance / (n.R / float64(n.universe.opts.Annuli))))
 		histogram[annulus]++
 	}
 	return histogram
@@ -252,6 +447,9 @@ func average(v []float64) float64 {
 
 func columnCovariance(a, b int, m mat64.Matrix) float64 {
 	r, _ := m.Dims()
+	if r == 0 {
+		return 0
+	}
 
 	sum := float64(0)
 
@@ -279,16 +477,39 @@ func covariance(m mat64.Matrix) mat64.Matrix {
 }
 
 // Approximates the normal of a point cloud by getting the eigenvector of the
-// covariance matrix with lowest magnitude.
+// covariance matrix with lowest magnitude, and points it in the direction
+// chosen by the analyzer's LFSHOptions.Orientation.
 func (n *neighborhood) Normal() mat64.Vector {
-	if n.normal != nil {
-		// Return cached value if it has already been calculated.
-		return *n.normal
-	}
+	// Only calculated the first time it's asked for.
+	n.normalOnce.Do(func() {
+		normal := n.unorientedNormal()
+		if dot3(mat64.Col(nil, 0, &normal), n.universe.orientationReference(n.col)) < 0 {
+			normal.ScaleVec(-1, &normal)
+		}
+		n.normal = &normal
+	})
+	return *n.normal
+}
+
+// Returns the normal with an arbitrary sign, which is cheaper when only the
+// plane it defines matters.
+func (n *neighborhood) unorientedNormal() mat64.Vector {
+	n.eigenOnce.Do(n.calculateEigen)
+	normal := *mat64.NewVector(3, nil)
+	normal.CopyVec(&n.unoriented)
+	return normal
+}
 
+func (n *neighborhood) calculateEigen() {
+	n.eigenvalues = make([]float64, 3)
+	n.unoriented = *mat64.NewVector(3, nil)
 	covMatrix := covariance(n.Dense.T())
 	e := mat64.Eigen{}
-	e.Factorize(covMatrix, true)
+	if !e.Factorize(covMatrix, true) {
+		// Leaving every eigenvalue at 0 marks the neighborhood as
+		// degenerate.
+		return
+	}
 	eigenValues := e.Values(nil)
 	mindex := 0
 	for i := 0; i < len(eigenValues); i++ {
@@ -297,7 +518,34 @@ func (n *neighborhood) Normal() mat64.Vector {
 		}
 	}
 
-	meigenVector := e.Vectors().ColView(mindex)
-	n.normal = meigenVector
-	return *meigenVector
+	n.unoriented = unit(*e.Vectors().ColView(mindex))
+	for i := range eigenValues {
+		n.eigenvalues[i] = real(eigenValues[i])
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(n.eigenvalues)))
+}
+
+// Returns the eigenvalues of the neighborhood's covariance matrix, from largest
+// to smallest. They describe how far the points spread along each of the
+// principal axes, the last of which is the normal.
+func (n *neighborhood) Eigenvalues() []float64 {
+	n.eigenOnce.Do(n.calculateEigen)
+	return n.eigenvalues
+}
+
+// Points are considered collinear if the spread along the second principal
+// axis is less than this fraction of the spread along the first, by variance.
+const collinearity = 1e-3
+
+// Whether the neighborhood has too few points, or too narrow a spread of
+// points, to define a plane. Its normal, and anything computed from it, is
+// meaningless if so.
+func (n *neighborhood) Degenerate() bool {
+	return len(n.members) < n.universe.opts.MinNeighbors || n.collinear()
+}
+
+// Whether the points all lie on about the same line, or at the same point.
+func (n *neighborhood) collinear() bool {
+	e := n.Eigenvalues()
+	return !(e[0] > 0) || e[1] < collinearity*e[0]
 }
@@ -0,0 +1,96 @@
+package points
+
+import (
+	"testing"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// Returns a 5x5 patch of a wall 5 meters to the side followed by a 21x21 patch
+// of floor a meter away, both with points 2cm apart, and the column of the
+// floor's middle point.
+func floorBehindWall() (*mat64.Dense, int) {
+	var cols [][]float64
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			cols = append(cols, []float64{5, float64(i) * 0.02, 1 + float64(j)*0.02})
+		}
+	}
+	center := -1
+	for i := -10; i <= 10; i++ {
+		for j := -10; j <= 10; j++ {
+			if i == 0 && j == 0 {
+				center = len(cols)
+			}
+			cols = append(cols, []float64{float64(i) * 0.02, float64(j) * 0.02, 1})
+		}
+	}
+	m := mat64.NewDense(3, len(cols), nil)
+	for j, col := range cols {
+		m.SetCol(j, col)
+	}
+	return m, center
+}
+
+// Every point near the middle of the floor has the same normal, so they should
+// all be in the first bucket. Comparing with the normals of the first columns
+// of the cloud instead of the neighborhood's members would count the wall.
+func TestNormalDevianceHistogramUsesMembers(t *testing.T) {
+	points, center := floorBehindWall()
+	a := &PointCloudAnalyzer{}
+	a.MakePointCloudAnalyzer(points)
+	d := a.Descriptor(center)
+	if !d.Valid {
+		t.Fatal("got an invalid descriptor for the middle of the floor")
+	}
+	members := len(a.getNeighborhood(center, a.Options().Radius).members)
+	if got := d.NormalDevianceHistogram; len(got) != 1 || got[0] != members {
+		t.Errorf("got normal deviance histogram %v. want all %d neighbors in bucket 0", got, members)
+	}
+}
+
+// The floor is flat, so every point near its middle is R below the plane that
+// its neighborhood is projected onto, and lands on that plane at its distance
+// from the middle point.
+func TestHistogramBuckets(t *testing.T) {
+	points, center := floorBehindWall()
+	opts := DefaultLFSHOptions()
+	// Not a multiple of the 2cm between points, so that no point is on the
+	// boundary of an annulus or of the neighborhood.
+	opts.Radius = 0.105
+	opts.DepthBuckets = 3
+	opts.Annuli = 3
+	a := &PointCloudAnalyzer{}
+	if err := a.MakePointCloudAnalyzerWithOptions(points, opts); err != nil {
+		t.Fatal(err)
+	}
+	d := a.Descriptor(center)
+	if !d.Valid {
+		t.Fatal("got an invalid descriptor for the middle of the floor")
+	}
+	// 89 points are within 10.5cm, or 5.25 points, of the middle one. Each
+	// bucket of depth is 7cm wide, and each annulus 3.5cm, or 1.75 points.
+	for _, tc := range []struct {
+		name      string
+		got, want map[int]int
+	}{
+		{"local depth", d.LocalDepthHistogram, map[int]int{1: 89}},
+		{"radial density", d.RadialDensityHistogram, map[int]int{0: 9, 1: 28, 2: 52}},
+	} {
+		if !equalHistograms(tc.got, tc.want) {
+			t.Errorf("got %s histogram %v. want %v", tc.name, tc.got, tc.want)
+		}
+	}
+}
+
+func equalHistograms(a, b map[int]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for bucket, count := range a {
+		if b[bucket] != count {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package points
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// Computes the rigid transform (rotation and translation, no scaling) that best
+// maps each column of src onto the same column of dst, in the least squares
+// sense. Both matrices are 3xN, with one point per column. The transform is
+// returned as a 4x4 homogeneous matrix.
+//
+// This uses Horn's closed form solution with unit quaternions:
+// https://doi.org/10.1364/JOSAA.4.000629
+func RigidTransform(src, dst *mat64.Dense) (*mat64.Dense, error) {
+	sr, sc := src.Dims()
+	dr, dc := dst.Dims()
+	if sr != 3 || dr != 3 {
+		return nil, fmt.Errorf("expected 3xN matrices. got %dx%d and %dx%d", sr, sc, dr, dc)
+	}
+	if sc != dc {
+		return nil, fmt.Errorf("expected the same number of points in src and dst. got %d and %d", sc, dc)
+	}
+	if sc < 3 {
+		return nil, fmt.Errorf("expected at least 3 points. got %d", sc)
+	}
+
+	var srcCenter, dstCenter [3]float64
+	for j := 0; j < sc; j++ {
+		for i := 0; i < 3; i++ {
+			srcCenter[i] += src.At(i, j) / float64(sc)
+			dstCenter[i] += dst.At(i, j) / float64(sc)
+		}
+	}
+
+	// Cross covariance of the centered points. s[a][b] sums src_a * dst_b.
+	var s [3][3]float64
+	for j := 0; j < sc; j++ {
+		for a := 0; a < 3; a++ {
+			for b := 0; b < 3; b++ {
+				s[a][b] += (src.At(a, j) - srcCenter[a]) * (dst.At(b, j) - dstCenter[b])
+			}
+		}
+	}
+
+	// The quaternion that maximizes the fit is the eigenvector of this matrix
+	// with the largest eigenvalue.
+	n := mat64.NewSymDense(4, []float64{
+		s[0][0] + s[1][1] + s[2][2], s[1][2] - s[2][1], s[2][0] - s[0][2], s[0][1] - s[1][0],
+		s[1][2] - s[2][1], s[0][0] - s[1][1] - s[2][2], s[0][1] + s[1][0], s[2][0] + s[0][2],
+		s[2][0] - s[0][2], s[0][1] + s[1][0], -s[0][0] + s[1][1] - s[2][2], s[1][2] + s[2][1],
+		s[0][1] - s[1][0], s[2][0] + s[0][2], s[1][2] + s[2][1], -s[0][0] - s[1][1] + s[2][2],
+	})
+	var eigen mat64.EigenSym
+	if !eigen.Factorize(n, true) {
+		return nil, errors.New("failed to factorize the quaternion matrix")
+	}
+	values := eigen.Values(nil)
+	maxIndex := 0
+	for i := range values {
+		if values[i] > values[maxIndex] {
+			maxIndex = i
+		}
+	}
+	var vectors mat64.Dense
+	vectors.EigenvectorsSym(&eigen)
+	q := mat64.Col(nil, maxIndex, &vectors)
+	norm := math.Sqrt(q[0]*q[0] + q[1]*q[1] + q[2]*q[2] + q[3]*q[3])
+	w, x, y, z := q[0]/norm, q[1]/norm, q[2]/norm, q[3]/norm
+
+	rotation := [3][3]float64{
+		{w*w + x*x - y*y - z*z, 2 * (x*y - w*z), 2 * (x*z + w*y)},
+		{2 * (x*y + w*z), w*w - x*x + y*y - z*z, 2 * (y*z - w*x)},
+		{2 * (x*z - w*y), 2 * (y*z + w*x), w*w - x*x - y*y + z*z},
+	}
+	transform := mat64.NewDense(4, 4, nil)
+	for i := 0; i < 3; i++ {
+		t := dstCenter[i]
+		for k := 0; k < 3; k++ {
+			transform.Set(i, k, rotation[i][k])
+			t -= rotation[i][k] * srcCenter[k]
+		}
+		transform.Set(i, 3, t)
+	}
+	transform.Set(3, 3, 1)
+	return transform, nil
+}
+
+// Applies a 4x4 homogeneous transform to each column of a 3xN matrix of
+// points, and returns the transformed points.
+func TransformPoints(transform, points *mat64.Dense) *mat64.Dense {
+	_, c := points.Dims()
+	out := mat64.NewDense(3, c, nil)
+	for j := 0; j < c; j++ {
+		x, y, z := points.At(0, j), points.At(1, j), points.At(2, j)
+		for i := 0; i < 3; i++ {
+			out.Set(i, j, transform.At(i, 0)*x+transform.At(i, 1)*y+transform.At(i, 2)*z+transform.At(i, 3))
+		}
+	}
+	return out
+}
@@ -0,0 +1,124 @@
+package points
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gonum/matrix/mat64"
+)
+
+// Index of a cube in a grid of cubes of some size, one of which has a corner at
+// the origin.
+type Voxel [3]int64
+
+// Returns the voxel that holds point, which holds x, y and z, in a grid of
+// cubes of the given size.
+func VoxelOf(point []float64, size float64) Voxel {
+	return Voxel{
+		int64(math.Floor(point[0] / size)),
+		int64(math.Floor(point[1] / size)),
+		int64(math.Floor(point[2] / size)),
+	}
+}
+
+// A way of reducing the points in a voxel to one point.
+type VoxelMode int
+
+const (
+	// The centroid of the voxel's points. This averages out noise.
+	VoxelCentroid VoxelMode = iota
+	// The one of the voxel's points that is nearest their centroid, so that
+	// only measured points are kept.
+	VoxelNearestToCentroid
+)
+
+func (m VoxelMode) String() string {
+	switch m {
+	case VoxelCentroid:
+		return "centroid"
+	case VoxelNearestToCentroid:
+		return "nearest to centroid"
+	}
+	return fmt.Sprintf("VoxelMode(%d)", int(m))
+}
+
+// Downsamples a 3xN matrix of points, with one point per column, to at most
+// one point per cube of the given size. Points are returned in the order of
+// the first column that landed in each cube.
+func VoxelGridFilter(points *mat64.Dense, leafSize float64, mode VoxelMode) (*mat64.Dense, error) {
+	if r, _ := points.Dims(); r != 3 {
+		return nil, fmt.Errorf("expected 3xN points. got %d rows", r)
+	}
+	if err := validateVoxelSize(leafSize); err != nil {
+		return nil, err
+	}
+	if mode != VoxelCentroid && mode != VoxelNearestToCentroid {
+		return nil, fmt.Errorf("unknown voxel mode: %v", mode)
+	}
+	groups := voxelGroups(points, leafSize)
+	filtered := mat64.NewDense(3, 0, nil).Grow(0, len(groups)).(*mat64.Dense)
+	for j, cols := range groups {
+		switch mode {
+		case VoxelCentroid:
+			c := centroid(points, cols)
+			filtered.SetCol(j, c[:])
+		case VoxelNearestToCentroid:
+			filtered.SetCol(j, mat64.Col(nil, nearestToCentroid(points, cols), points))
+		}
+	}
+	return filtered, nil
+}
+
+func validateVoxelSize(size float64) error {
+	if !(size > 0) || math.IsInf(size, 1) {
+		return fmt.Errorf("expected a positive, finite voxel size. got %v", size)
+	}
+	return nil
+}
+
+// Groups the columns of points by the voxel they're in. Groups are in the
+// order of their first column, and columns within them are ascending.
+func voxelGroups(points *mat64.Dense, size float64) [][]int {
+	_, c := points.Dims()
+	indices := make(map[Voxel]int)
+	var groups [][]int
+	for col := 0; col < c; col++ {
+		v := VoxelOf(mat64.Col(nil, col, points), size)
+		i, ok := indices[v]
+		if !ok {
+			i = len(groups)
+			indices[v] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], col)
+	}
+	return groups
+}
+
+func centroid(points *mat64.Dense, cols []int) [3]float64 {
+	var c [3]float64
+	for _, col := range cols {
+		for i := 0; i < 3; i++ {
+			c[i] += points.At(i, col) / float64(len(cols))
+		}
+	}
+	return c
+}
+
+// Returns the one of cols whose point is nearest to the centroid of their
+// points.
+func nearestToCentroid(points *mat64.Dense, cols []int) int {
+	c := centroid(points, cols)
+	best, bestDistance := -1, math.Inf(1)
+	for _, col := range cols {
+		d := float64(0)
+		for i := 0; i < 3; i++ {
+			diff := points.At(i, col) - c[i]
+			d += diff * diff
+		}
+		if d < bestDistance {
+			best, bestDistance = col, d
+		}
+	}
+	return best
+}
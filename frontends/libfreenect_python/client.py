@@ -6,6 +6,7 @@ import meshbuilder_pb2
 import meshbuilder_pb2_grpc
 import grpc
 import sys
+import zlib
 
 #function to get RGB image from kinect
 def get_video():
@@ -22,8 +23,10 @@ def get_depth():
 def display_depth(dev, data, timestamp):
     global keep_running
     global depth
-    depth = data.astype(np.uint8)
-    cv2.imshow('Depth', depth)
+    # Keep the raw 11-bit disparity to send to the server. It's only squashed
+    # into 8 bits for display.
+    depth = data
+    cv2.imshow('Depth', depth.astype(np.uint8))
     if cv2.waitKey(10) == 27:
         keep_running = False
   
@@ -54,11 +57,15 @@ def loop(*args):
   # Stuff frame in proto.
   proto = meshbuilder_pb2.AddRequest()
   proto.name = project_name
-  for row in depth:
-    new_row = proto.depth.rows.add()
-    new_row.values[:] = row
+  # Send the frame as packed 16-bit values rather than rows of varints. It's
+  # much smaller on the wire and quicker to build.
+  proto.depth.packed.height, proto.depth.packed.width = depth.shape
+  proto.depth.packed.bit_depth = 16
+  proto.depth.packed.compression = meshbuilder_pb2.PackedDepth.ZLIB
+  proto.depth.packed.data = zlib.compress(depth.astype('<u2').tobytes())
   proto.depth.x_fov = 58.5
   proto.depth.y_fov = 46.6
+  proto.depth.encoding = meshbuilder_pb2.Depth.KINECT_DISPARITY
   
   print("depth_in_proto")
 
@@ -97,9 +104,13 @@ def main():
   # Create the project (if it doesn't already exist) and get the project ID.
   create_project_proto = meshbuilder_pb2.CreateProjectRequest()
   create_project_proto.name = project_name
-  stub.CreateProject(create_project_proto)
+  create_project_proto.get_or_create = True
+  response = stub.CreateProject(create_project_proto)
   
-  print("Project created!.")
+  if response.created:
+    print("Project created! ID: {0}".format(response.id))
+  else:
+    print("Resuming project with {0} frames. ID: {1}".format(response.project.frame_count, response.id))
 
 
   print("Streaming frames...")
@@ -19,12 +19,72 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='meshbuilder.proto',
   package='',
   syntax='proto3',
-  serialized_pb=_b('\n\x11meshbuilder.proto\"$\n\x14\x43reateProjectRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x17\n\x15\x43reateProjectResponse\"1\n\nAddRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x15\n\x05\x64\x65pth\x18\x02 \x01(\x0b\x32\x06.Depth\"\r\n\x0b\x41\x64\x64Response\"\x1f\n\x0fRetrieveRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"*\n\x10RetrieveResponse\x12\x16\n\x06points\x18\x01 \x03(\x0b\x32\x06.Point\"(\n\x05Point\x12\t\n\x01X\x18\x01 \x01(\x02\x12\t\n\x01Y\x18\x02 \x01(\x02\x12\t\n\x01Z\x18\x03 \x01(\x02\"9\n\x05\x44\x65pth\x12\x12\n\x04rows\x18\x01 \x03(\x0b\x32\x04.Row\x12\r\n\x05x_fov\x18\x02 \x01(\x02\x12\r\n\x05y_fov\x18\x03 \x01(\x02\"\x15\n\x03Row\x12\x0e\n\x06values\x18\x01 \x03(\x05\x32\xa6\x01\n\x0bMeshBuilder\x12@\n\rCreateProject\x12\x15.CreateProjectRequest\x1a\x16.CreateProjectResponse\"\x00\x12\"\n\x03\x41\x64\x64\x12\x0b.AddRequest\x1a\x0c.AddResponse\"\x00\x12\x31\n\x08Retrieve\x12\x10.RetrieveRequest\x1a\x11.RetrieveResponse\"\x00\x62\x06proto3')
+  serialized_pb=_b('\n\x11meshbuilder.proto\"b\n\x14\x43reateProjectRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x15\n\rget_or_create\x18\x02 \x01(\x08\x12%\n\rpreprocessing\x18\x03 \x01(\x0b\x32\x0e.Preprocessing\"S\n\x15\x43reateProjectResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x1d\n\x07project\x18\x02 \x01(\x0b\x32\x0c.ProjectInfo\x12\x0f\n\x07\x63reated\x18\x03 \x01(\x08\"1\n\nAddRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x15\n\x05\x64\x65pth\x18\x02 \x01(\x0b\x32\x06.Depth\"2\n\x0b\x41\x64\x64Response\x12#\n\x0cregistration\x18\x01 \x01(\x0b\x32\r.Registration\"\\\n\x10\x41\x64\x64StreamRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x10\n\x08sequence\x18\x02 \x01(\x04\x12\x11\n\ttimestamp\x18\x03 \x01(\x03\x12\x15\n\x05\x64\x65pth\x18\x04 \x01(\x0b\x32\x06.Depth\"Z\n\x11\x41\x64\x64StreamResponse\x12\x17\n\x0f\x66rames_accepted\x18\x01 \x01(\x03\x12\x16\n\x0e\x66rames_dropped\x18\x02 \x01(\x03\x12\x14\n\x0cpoints_added\x18\x03 \x01(\x03\"\x1f\n\x0fRetrieveRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"*\n\x10RetrieveResponse\x12\x16\n\x06points\x18\x01 \x03(\x0b\x32\x06.Point\"H\n\x15RetrieveStreamRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nmax_points\x18\x02 \x01(\x05\x12\r\n\x05watch\x18\x03 \x01(\x08\"X\n\x16RetrieveStreamResponse\x12\x16\n\x06points\x18\x01 \x03(\x0b\x32\x06.Point\x12\x13\n\x0bpoints_sent\x18\x02 \x01(\x03\x12\x11\n\tcaught_up\x18\x03 \x01(\x08\"\xd2\x01\n\x0bProjectInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x66rame_count\x18\x02 \x01(\x03\x12\x13\n\x0bpoint_count\x18\x03 \x01(\x03\x12\x1a\n\nbounds_min\x18\x04 \x01(\x0b\x32\x06.Point\x12\x1a\n\nbounds_max\x18\x05 \x01(\x0b\x32\x06.Point\x12\x0f\n\x07\x63reated\x18\x06 \x01(\x03\x12\x0f\n\x07updated\x18\x07 \x01(\x03\x12\n\n\x02id\x18\x08 \x01(\t\x12%\n\rpreprocessing\x18\t \x01(\x0b\x32\x0e.Preprocessing\"\x15\n\x13ListProjectsRequest\"6\n\x14ListProjectsResponse\x12\x1e\n\x08projects\x18\x01 \x03(\x0b\x32\x0c.ProjectInfo\"!\n\x11GetProjectRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"3\n\x12GetProjectResponse\x12\x1d\n\x07project\x18\x01 \x01(\x0b\x32\x0c.ProjectInfo\"$\n\x14\x44\x65leteProjectRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x17\n\x15\x44\x65leteProjectResponse\"6\n\x14RenameProjectRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x10\n\x08new_name\x18\x02 \x01(\t\"\x17\n\x15RenameProjectResponse\"#\n\x13\x43learProjectRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x16\n\x14\x43learProjectResponse\"N\n\x17SetPreprocessingRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12%\n\rpreprocessing\x18\x02 \x01(\x0b\x32\x0e.Preprocessing\"\x1a\n\x18SetPreprocessingResponse\"\xac\x01\n\rPreprocessing\x12?\n\x1bstatistical_outlier_removal\x18\x01 \x01(\x0b\x32\x1a.StatisticalOutlierRemoval\x12\x35\n\x16radius_outlier_removal\x18\x02 \x01(\x0b\x32\x15.RadiusOutlierRemoval\x12#\n\rdepth_filters\x18\x03 \x03(\x0b\x32\x0c.DepthFilter\"J\n\x19StatisticalOutlierRemoval\x12\x11\n\tneighbors\x18\x01 \x01(\x05\x12\x1a\n\x12std_dev_multiplier\x18\x02 \x01(\x01\"=\n\x14RadiusOutlierRemoval\x12\x0e\n\x06radius\x18\x01 \x01(\x01\x12\x15\n\rmin_neighbors\x18\x02 \x01(\x05\"\xb2\x01\n\x0b\x44\x65pthFilter\x12%\n\tbilateral\x18\x01 \x01(\x0b\x32\x10.BilateralFilterH\x00\x12\x1f\n\x06median\x18\x02 \x01(\x0b\x32\r.MedianFilterH\x00\x12+\n\rflying_pixels\x18\x03 \x01(\x0b\x32\x12.FlyingPixelFilterH\x00\x12$\n\x0chole_filling\x18\x04 \x01(\x0b\x32\x0c.HoleFillingH\x00\x42\x08\n\x06\x66ilter\"M\n\x0f\x42ilateralFilter\x12\x0e\n\x06radius\x18\x01 \x01(\x05\x12\x15\n\rspatial_sigma\x18\x02 \x01(\x01\x12\x13\n\x0brange_sigma\x18\x03 \x01(\x01\"\x1e\n\x0cMedianFilter\x12\x0e\n\x06radius\x18\x01 \x01(\x05\".\n\x11\x46lyingPixelFilter\x12\x19\n\x11max_relative_jump\x18\x01 \x01(\x01\"4\n\x0bHoleFilling\x12\x0e\n\x06radius\x18\x01 \x01(\x05\x12\x15\n\rmin_neighbors\x18\x02 \x01(\x05\"X\n\x05\x46rame\x12\x10\n\x08sequence\x18\x01 \x01(\x04\x12\x11\n\ttimestamp\x18\x02 \x01(\x03\x12\x15\n\x05\x64\x65pth\x18\x03 \x01(\x0b\x32\x06.Depth\x12\x13\n\x04pose\x18\x04 \x01(\x0b\x32\x05.Pose\"j\n\x0cRegistration\x12\x13\n\x04pose\x18\x01 \x01(\x0b\x32\x05.Pose\x12\x12\n\nregistered\x18\x02 \x01(\x08\x12\x0c\n\x04rmse\x18\x03 \x01(\x01\x12\x0f\n\x07inliers\x18\x04 \x01(\x05\x12\x12\n\niterations\x18\x05 \x01(\x05\"\x16\n\x04Pose\x12\x0e\n\x06matrix\x18\x01 \x03(\x01\"(\n\x05Point\x12\t\n\x01X\x18\x01 \x01(\x02\x12\t\n\x01Y\x18\x02 \x01(\x02\x12\t\n\x01Z\x18\x03 \x01(\x02\"\x8e\x02\n\x05\x44\x65pth\x12\x12\n\x04rows\x18\x01 \x03(\x0b\x32\x04.Row\x12\r\n\x05x_fov\x18\x02 \x01(\x02\x12\r\n\x05y_fov\x18\x03 \x01(\x02\x12!\n\x08\x65ncoding\x18\x04 \x01(\x0e\x32\x0f.Depth.Encoding\x12\x11\n\tmin_depth\x18\x05 \x01(\x02\x12\x11\n\tmax_depth\x18\x06 \x01(\x02\x12\x1c\n\x06packed\x18\x07 \x01(\x0b\x32\x0c.PackedDepth\"l\n\x08\x45ncoding\x12\x18\n\x14\x45NCODING_UNSPECIFIED\x10\x00\x12\x0f\n\x0bMILLIMETERS\x10\x01\x12\x14\n\x10KINECT_DISPARITY\x10\x02\x12\n\n\x06METERS\x10\x03\x12\x13\n\x0fNORMALIZED_8BIT\x10\x04\"\xa8\x01\n\x0bPackedDepth\x12\r\n\x05width\x18\x01 \x01(\x05\x12\x0e\n\x06height\x18\x02 \x01(\x05\x12\x11\n\tbit_depth\x18\x03 \x01(\x05\x12-\n\x0b\x63ompression\x18\x04 \x01(\x0e\x32\x18.PackedDepth.Compression\x12\x0c\n\x04\x64\x61ta\x18\x05 \x01(\x0c\"*\n\x0b\x43ompression\x12\x08\n\x04NONE\x10\x00\x12\x08\n\x04ZLIB\x10\x01\x12\x07\n\x03PNG\x10\x02\"+\n\x03Row\x12\x0e\n\x06values\x18\x01 \x03(\x05\x12\x14\n\x0c\x66loat_values\x18\x02 \x03(\x02\x32\xab\x05\n\x0bMeshBuilder\x12@\n\rCreateProject\x12\x15.CreateProjectRequest\x1a\x16.CreateProjectResponse\"\x00\x12\"\n\x03\x41\x64\x64\x12\x0b.AddRequest\x1a\x0c.AddResponse\"\x00\x12\x31\n\x08Retrieve\x12\x10.RetrieveRequest\x1a\x11.RetrieveResponse\"\x00\x12\x36\n\tAddStream\x12\x11.AddStreamRequest\x1a\x12.AddStreamResponse\"\x00(\x01\x12\x45\n\x0eRetrieveStream\x12\x16.RetrieveStreamRequest\x1a\x17.RetrieveStreamResponse\"\x00\x30\x01\x12=\n\x0cListProjects\x12\x14.ListProjectsRequest\x1a\x15.ListProjectsResponse\"\x00\x12\x37\n\nGetProject\x12\x12.GetProjectRequest\x1a\x13.GetProjectResponse\"\x00\x12@\n\rDeleteProject\x12\x15.DeleteProjectRequest\x1a\x16.DeleteProjectResponse\"\x00\x12@\n\rRenameProject\x12\x15.RenameProjectRequest\x1a\x16.RenameProjectResponse\"\x00\x12=\n\x0c\x43learProject\x12\x14.ClearProjectRequest\x1a\x15.ClearProjectResponse\"\x00\x12I\n\x10SetPreprocessing\x12\x18.SetPreprocessingRequest\x1a\x19.SetPreprocessingResponse\"\x00\x62\x06proto3')
 )
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 
 
+_DEPTH_ENCODING = _descriptor.EnumDescriptor(
+  name='Encoding',
+  full_name='Depth.Encoding',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='ENCODING_UNSPECIFIED', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='MILLIMETERS', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='KINECT_DISPARITY', index=2, number=2,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='METERS', index=3, number=3,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='NORMALIZED_8BIT', index=4, number=4,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=2564,
+  serialized_end=2672,
+)
+_sym_db.RegisterEnumDescriptor(_DEPTH_ENCODING)
+
+_PACKEDDEPTH_COMPRESSION = _descriptor.EnumDescriptor(
+  name='Compression',
+  full_name='PackedDepth.Compression',
+  filename=None,
+  file=DESCRIPTOR,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='NONE', index=0, number=0,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='ZLIB', index=1, number=1,
+      options=None,
+      type=None),
+    _descriptor.EnumValueDescriptor(
+      name='PNG', index=2, number=2,
+      options=None,
+      type=None),
+  ],
+  containing_type=None,
+  options=None,
+  serialized_start=2801,
+  serialized_end=2843,
+)
+_sym_db.RegisterEnumDescriptor(_PACKEDDEPTH_COMPRESSION)
+
 
 _CREATEPROJECTREQUEST = _descriptor.Descriptor(
   name='CreateProjectRequest',
@@ -40,6 +100,20 @@ _CREATEPROJECTREQUEST = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='get_or_create', full_name='CreateProjectRequest.get_or_create', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='preprocessing', full_name='CreateProjectRequest.preprocessing', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -53,7 +127,7 @@ _CREATEPROJECTREQUEST = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=21,
-  serialized_end=57,
+  serialized_end=119,
 )
 
 
@@ -64,6 +138,27 @@ _CREATEPROJECTRESPONSE = _descriptor.Descriptor(
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='CreateProjectResponse.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='project', full_name='CreateProjectResponse.project', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='created', full_name='CreateProjectResponse.created', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -76,8 +171,8 @@ _CREATEPROJECTRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=59,
-  serialized_end=82,
+  serialized_start=121,
+  serialized_end=204,
 )
 
 
@@ -114,8 +209,8 @@ _ADDREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=84,
-  serialized_end=133,
+  serialized_start=206,
+  serialized_end=255,
 )
 
 
@@ -126,6 +221,110 @@ _ADDRESPONSE = _descriptor.Descriptor(
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
+    _descriptor.FieldDescriptor(
+      name='registration', full_name='AddResponse.registration', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=257,
+  serialized_end=307,
+)
+
+
+_ADDSTREAMREQUEST = _descriptor.Descriptor(
+  name='AddStreamRequest',
+  full_name='AddStreamRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='AddStreamRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='sequence', full_name='AddStreamRequest.sequence', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='AddStreamRequest.timestamp', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='depth', full_name='AddStreamRequest.depth', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=309,
+  serialized_end=401,
+)
+
+
+_ADDSTREAMRESPONSE = _descriptor.Descriptor(
+  name='AddStreamResponse',
+  full_name='AddStreamResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='frames_accepted', full_name='AddStreamResponse.frames_accepted', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='frames_dropped', full_name='AddStreamResponse.frames_dropped', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='points_added', full_name='AddStreamResponse.points_added', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -138,8 +337,8 @@ _ADDRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=135,
-  serialized_end=148,
+  serialized_start=403,
+  serialized_end=493,
 )
 
 
@@ -151,9 +350,997 @@ _RETRIEVEREQUEST = _descriptor.Descriptor(
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='name', full_name='RetrieveRequest.name', index=0,
-      number=1, type=9, cpp_type=9, label=1,
-      has_default_value=False, default_value=_b("").decode('utf-8'),
+      name='name', full_name='RetrieveRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=495,
+  serialized_end=526,
+)
+
+
+_RETRIEVERESPONSE = _descriptor.Descriptor(
+  name='RetrieveResponse',
+  full_name='RetrieveResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='points', full_name='RetrieveResponse.points', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=528,
+  serialized_end=570,
+)
+
+
+_RETRIEVESTREAMREQUEST = _descriptor.Descriptor(
+  name='RetrieveStreamRequest',
+  full_name='RetrieveStreamRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='RetrieveStreamRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max_points', full_name='RetrieveStreamRequest.max_points', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='watch', full_name='RetrieveStreamRequest.watch', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=572,
+  serialized_end=644,
+)
+
+
+_RETRIEVESTREAMRESPONSE = _descriptor.Descriptor(
+  name='RetrieveStreamResponse',
+  full_name='RetrieveStreamResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='points', full_name='RetrieveStreamResponse.points', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='points_sent', full_name='RetrieveStreamResponse.points_sent', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='caught_up', full_name='RetrieveStreamResponse.caught_up', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=646,
+  serialized_end=734,
+)
+
+
+_PROJECTINFO = _descriptor.Descriptor(
+  name='ProjectInfo',
+  full_name='ProjectInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='ProjectInfo.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='frame_count', full_name='ProjectInfo.frame_count', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='point_count', full_name='ProjectInfo.point_count', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='bounds_min', full_name='ProjectInfo.bounds_min', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='bounds_max', full_name='ProjectInfo.bounds_max', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='created', full_name='ProjectInfo.created', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='updated', full_name='ProjectInfo.updated', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='ProjectInfo.id', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='preprocessing', full_name='ProjectInfo.preprocessing', index=8,
+      number=9, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=737,
+  serialized_end=947,
+)
+
+
+_LISTPROJECTSREQUEST = _descriptor.Descriptor(
+  name='ListProjectsRequest',
+  full_name='ListProjectsRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=949,
+  serialized_end=970,
+)
+
+
+_LISTPROJECTSRESPONSE = _descriptor.Descriptor(
+  name='ListProjectsResponse',
+  full_name='ListProjectsResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='projects', full_name='ListProjectsResponse.projects', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=972,
+  serialized_end=1026,
+)
+
+
+_GETPROJECTREQUEST = _descriptor.Descriptor(
+  name='GetProjectRequest',
+  full_name='GetProjectRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='GetProjectRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1028,
+  serialized_end=1061,
+)
+
+
+_GETPROJECTRESPONSE = _descriptor.Descriptor(
+  name='GetProjectResponse',
+  full_name='GetProjectResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='project', full_name='GetProjectResponse.project', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1063,
+  serialized_end=1114,
+)
+
+
+_DELETEPROJECTREQUEST = _descriptor.Descriptor(
+  name='DeleteProjectRequest',
+  full_name='DeleteProjectRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='DeleteProjectRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1116,
+  serialized_end=1152,
+)
+
+
+_DELETEPROJECTRESPONSE = _descriptor.Descriptor(
+  name='DeleteProjectResponse',
+  full_name='DeleteProjectResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1154,
+  serialized_end=1177,
+)
+
+
+_RENAMEPROJECTREQUEST = _descriptor.Descriptor(
+  name='RenameProjectRequest',
+  full_name='RenameProjectRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='RenameProjectRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='new_name', full_name='RenameProjectRequest.new_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1179,
+  serialized_end=1233,
+)
+
+
+_RENAMEPROJECTRESPONSE = _descriptor.Descriptor(
+  name='RenameProjectResponse',
+  full_name='RenameProjectResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1235,
+  serialized_end=1258,
+)
+
+
+_CLEARPROJECTREQUEST = _descriptor.Descriptor(
+  name='ClearProjectRequest',
+  full_name='ClearProjectRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='ClearProjectRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1260,
+  serialized_end=1295,
+)
+
+
+_CLEARPROJECTRESPONSE = _descriptor.Descriptor(
+  name='ClearProjectResponse',
+  full_name='ClearProjectResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1297,
+  serialized_end=1319,
+)
+
+
+_SETPREPROCESSINGREQUEST = _descriptor.Descriptor(
+  name='SetPreprocessingRequest',
+  full_name='SetPreprocessingRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='SetPreprocessingRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='preprocessing', full_name='SetPreprocessingRequest.preprocessing', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1321,
+  serialized_end=1399,
+)
+
+
+_SETPREPROCESSINGRESPONSE = _descriptor.Descriptor(
+  name='SetPreprocessingResponse',
+  full_name='SetPreprocessingResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1401,
+  serialized_end=1427,
+)
+
+
+_PREPROCESSING = _descriptor.Descriptor(
+  name='Preprocessing',
+  full_name='Preprocessing',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='statistical_outlier_removal', full_name='Preprocessing.statistical_outlier_removal', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='radius_outlier_removal', full_name='Preprocessing.radius_outlier_removal', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='depth_filters', full_name='Preprocessing.depth_filters', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1430,
+  serialized_end=1602,
+)
+
+
+_STATISTICALOUTLIERREMOVAL = _descriptor.Descriptor(
+  name='StatisticalOutlierRemoval',
+  full_name='StatisticalOutlierRemoval',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='neighbors', full_name='StatisticalOutlierRemoval.neighbors', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='std_dev_multiplier', full_name='StatisticalOutlierRemoval.std_dev_multiplier', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1604,
+  serialized_end=1678,
+)
+
+
+_RADIUSOUTLIERREMOVAL = _descriptor.Descriptor(
+  name='RadiusOutlierRemoval',
+  full_name='RadiusOutlierRemoval',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='radius', full_name='RadiusOutlierRemoval.radius', index=0,
+      number=1, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='min_neighbors', full_name='RadiusOutlierRemoval.min_neighbors', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1680,
+  serialized_end=1741,
+)
+
+
+_DEPTHFILTER = _descriptor.Descriptor(
+  name='DepthFilter',
+  full_name='DepthFilter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='bilateral', full_name='DepthFilter.bilateral', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='median', full_name='DepthFilter.median', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='flying_pixels', full_name='DepthFilter.flying_pixels', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='hole_filling', full_name='DepthFilter.hole_filling', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1744,
+  serialized_end=1922,
+)
+
+
+_BILATERALFILTER = _descriptor.Descriptor(
+  name='BilateralFilter',
+  full_name='BilateralFilter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='radius', full_name='BilateralFilter.radius', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='spatial_sigma', full_name='BilateralFilter.spatial_sigma', index=1,
+      number=2, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='range_sigma', full_name='BilateralFilter.range_sigma', index=2,
+      number=3, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1924,
+  serialized_end=2001,
+)
+
+
+_MEDIANFILTER = _descriptor.Descriptor(
+  name='MedianFilter',
+  full_name='MedianFilter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='radius', full_name='MedianFilter.radius', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2003,
+  serialized_end=2033,
+)
+
+
+_FLYINGPIXELFILTER = _descriptor.Descriptor(
+  name='FlyingPixelFilter',
+  full_name='FlyingPixelFilter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='max_relative_jump', full_name='FlyingPixelFilter.max_relative_jump', index=0,
+      number=1, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2035,
+  serialized_end=2081,
+)
+
+
+_HOLEFILLING = _descriptor.Descriptor(
+  name='HoleFilling',
+  full_name='HoleFilling',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='radius', full_name='HoleFilling.radius', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='min_neighbors', full_name='HoleFilling.min_neighbors', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2083,
+  serialized_end=2135,
+)
+
+
+_FRAME = _descriptor.Descriptor(
+  name='Frame',
+  full_name='Frame',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='sequence', full_name='Frame.sequence', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='Frame.timestamp', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='depth', full_name='Frame.depth', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='pose', full_name='Frame.pose', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2137,
+  serialized_end=2225,
+)
+
+
+_REGISTRATION = _descriptor.Descriptor(
+  name='Registration',
+  full_name='Registration',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pose', full_name='Registration.pose', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='registered', full_name='Registration.registered', index=1,
+      number=2, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='rmse', full_name='Registration.rmse', index=2,
+      number=3, type=1, cpp_type=5, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='inliers', full_name='Registration.inliers', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='iterations', full_name='Registration.iterations', index=4,
+      number=5, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
@@ -169,21 +1356,21 @@ _RETRIEVEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=150,
-  serialized_end=181,
+  serialized_start=2227,
+  serialized_end=2333,
 )
 
 
-_RETRIEVERESPONSE = _descriptor.Descriptor(
-  name='RetrieveResponse',
-  full_name='RetrieveResponse',
+_POSE = _descriptor.Descriptor(
+  name='Pose',
+  full_name='Pose',
   filename=None,
   file=DESCRIPTOR,
   containing_type=None,
   fields=[
     _descriptor.FieldDescriptor(
-      name='points', full_name='RetrieveResponse.points', index=0,
-      number=1, type=11, cpp_type=10, label=3,
+      name='matrix', full_name='Pose.matrix', index=0,
+      number=1, type=1, cpp_type=5, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
@@ -200,8 +1387,8 @@ _RETRIEVERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=183,
-  serialized_end=225,
+  serialized_start=2335,
+  serialized_end=2357,
 )
 
 
@@ -245,8 +1432,8 @@ _POINT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=227,
-  serialized_end=267,
+  serialized_start=2359,
+  serialized_end=2399,
 )
 
 
@@ -278,11 +1465,100 @@ _DEPTH = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='encoding', full_name='Depth.encoding', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='min_depth', full_name='Depth.min_depth', index=4,
+      number=5, type=2, cpp_type=6, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='max_depth', full_name='Depth.max_depth', index=5,
+      number=6, type=2, cpp_type=6, label=1,
+      has_default_value=False, default_value=float(0),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='packed', full_name='Depth.packed', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _DEPTH_ENCODING,
+  ],
+  options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2402,
+  serialized_end=2672,
+)
+
+
+_PACKEDDEPTH = _descriptor.Descriptor(
+  name='PackedDepth',
+  full_name='PackedDepth',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='width', full_name='PackedDepth.width', index=0,
+      number=1, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='height', full_name='PackedDepth.height', index=1,
+      number=2, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='bit_depth', full_name='PackedDepth.bit_depth', index=2,
+      number=3, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='compression', full_name='PackedDepth.compression', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
+    _descriptor.FieldDescriptor(
+      name='data', full_name='PackedDepth.data', index=4,
+      number=5, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b(""),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
   nested_types=[],
   enum_types=[
+    _PACKEDDEPTH_COMPRESSION,
   ],
   options=None,
   is_extendable=False,
@@ -290,8 +1566,8 @@ _DEPTH = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=269,
-  serialized_end=326,
+  serialized_start=2675,
+  serialized_end=2843,
 )
 
 
@@ -309,6 +1585,13 @@ _ROW = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       options=None),
+    _descriptor.FieldDescriptor(
+      name='float_values', full_name='Row.float_values', index=1,
+      number=2, type=2, cpp_type=6, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      options=None),
   ],
   extensions=[
   ],
@@ -321,21 +1604,76 @@ _ROW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=328,
-  serialized_end=349,
+  serialized_start=2845,
+  serialized_end=2888,
 )
 
+_CREATEPROJECTREQUEST.fields_by_name['preprocessing'].message_type = _PREPROCESSING
+_CREATEPROJECTRESPONSE.fields_by_name['project'].message_type = _PROJECTINFO
 _ADDREQUEST.fields_by_name['depth'].message_type = _DEPTH
+_ADDRESPONSE.fields_by_name['registration'].message_type = _REGISTRATION
+_ADDSTREAMREQUEST.fields_by_name['depth'].message_type = _DEPTH
 _RETRIEVERESPONSE.fields_by_name['points'].message_type = _POINT
+_RETRIEVESTREAMRESPONSE.fields_by_name['points'].message_type = _POINT
+_PROJECTINFO.fields_by_name['bounds_min'].message_type = _POINT
+_PROJECTINFO.fields_by_name['bounds_max'].message_type = _POINT
+_PROJECTINFO.fields_by_name['preprocessing'].message_type = _PREPROCESSING
+_LISTPROJECTSRESPONSE.fields_by_name['projects'].message_type = _PROJECTINFO
+_GETPROJECTRESPONSE.fields_by_name['project'].message_type = _PROJECTINFO
+_SETPREPROCESSINGREQUEST.fields_by_name['preprocessing'].message_type = _PREPROCESSING
+_PREPROCESSING.fields_by_name['statistical_outlier_removal'].message_type = _STATISTICALOUTLIERREMOVAL
+_PREPROCESSING.fields_by_name['radius_outlier_removal'].message_type = _RADIUSOUTLIERREMOVAL
+_PREPROCESSING.fields_by_name['depth_filters'].message_type = _DEPTHFILTER
+_DEPTHFILTER.fields_by_name['bilateral'].message_type = _BILATERALFILTER
+_DEPTHFILTER.fields_by_name['median'].message_type = _MEDIANFILTER
+_DEPTHFILTER.fields_by_name['flying_pixels'].message_type = _FLYINGPIXELFILTER
+_DEPTHFILTER.fields_by_name['hole_filling'].message_type = _HOLEFILLING
+_FRAME.fields_by_name['depth'].message_type = _DEPTH
+_FRAME.fields_by_name['pose'].message_type = _POSE
+_REGISTRATION.fields_by_name['pose'].message_type = _POSE
 _DEPTH.fields_by_name['rows'].message_type = _ROW
+_DEPTH.fields_by_name['encoding'].enum_type = _DEPTH_ENCODING
+_DEPTH.fields_by_name['packed'].message_type = _PACKEDDEPTH
+_DEPTH_ENCODING.containing_type = _DEPTH
+_PACKEDDEPTH.fields_by_name['compression'].enum_type = _PACKEDDEPTH_COMPRESSION
+_PACKEDDEPTH_COMPRESSION.containing_type = _PACKEDDEPTH
 DESCRIPTOR.message_types_by_name['CreateProjectRequest'] = _CREATEPROJECTREQUEST
 DESCRIPTOR.message_types_by_name['CreateProjectResponse'] = _CREATEPROJECTRESPONSE
 DESCRIPTOR.message_types_by_name['AddRequest'] = _ADDREQUEST
 DESCRIPTOR.message_types_by_name['AddResponse'] = _ADDRESPONSE
+DESCRIPTOR.message_types_by_name['AddStreamRequest'] = _ADDSTREAMREQUEST
+DESCRIPTOR.message_types_by_name['AddStreamResponse'] = _ADDSTREAMRESPONSE
 DESCRIPTOR.message_types_by_name['RetrieveRequest'] = _RETRIEVEREQUEST
 DESCRIPTOR.message_types_by_name['RetrieveResponse'] = _RETRIEVERESPONSE
+DESCRIPTOR.message_types_by_name['RetrieveStreamRequest'] = _RETRIEVESTREAMREQUEST
+DESCRIPTOR.message_types_by_name['RetrieveStreamResponse'] = _RETRIEVESTREAMRESPONSE
+DESCRIPTOR.message_types_by_name['ProjectInfo'] = _PROJECTINFO
+DESCRIPTOR.message_types_by_name['ListProjectsRequest'] = _LISTPROJECTSREQUEST
+DESCRIPTOR.message_types_by_name['ListProjectsResponse'] = _LISTPROJECTSRESPONSE
+DESCRIPTOR.message_types_by_name['GetProjectRequest'] = _GETPROJECTREQUEST
+DESCRIPTOR.message_types_by_name['GetProjectResponse'] = _GETPROJECTRESPONSE
+DESCRIPTOR.message_types_by_name['DeleteProjectRequest'] = _DELETEPROJECTREQUEST
+DESCRIPTOR.message_types_by_name['DeleteProjectResponse'] = _DELETEPROJECTRESPONSE
+DESCRIPTOR.message_types_by_name['RenameProjectRequest'] = _RENAMEPROJECTREQUEST
+DESCRIPTOR.message_types_by_name['RenameProjectResponse'] = _RENAMEPROJECTRESPONSE
+DESCRIPTOR.message_types_by_name['ClearProjectRequest'] = _CLEARPROJECTREQUEST
+DESCRIPTOR.message_types_by_name['ClearProjectResponse'] = _CLEARPROJECTRESPONSE
+DESCRIPTOR.message_types_by_name['SetPreprocessingRequest'] = _SETPREPROCESSINGREQUEST
+DESCRIPTOR.message_types_by_name['SetPreprocessingResponse'] = _SETPREPROCESSINGRESPONSE
+DESCRIPTOR.message_types_by_name['Preprocessing'] = _PREPROCESSING
+DESCRIPTOR.message_types_by_name['StatisticalOutlierRemoval'] = _STATISTICALOUTLIERREMOVAL
+DESCRIPTOR.message_types_by_name['RadiusOutlierRemoval'] = _RADIUSOUTLIERREMOVAL
+DESCRIPTOR.message_types_by_name['DepthFilter'] = _DEPTHFILTER
+DESCRIPTOR.message_types_by_name['BilateralFilter'] = _BILATERALFILTER
+DESCRIPTOR.message_types_by_name['MedianFilter'] = _MEDIANFILTER
+DESCRIPTOR.message_types_by_name['FlyingPixelFilter'] = _FLYINGPIXELFILTER
+DESCRIPTOR.message_types_by_name['HoleFilling'] = _HOLEFILLING
+DESCRIPTOR.message_types_by_name['Frame'] = _FRAME
+DESCRIPTOR.message_types_by_name['Registration'] = _REGISTRATION
+DESCRIPTOR.message_types_by_name['Pose'] = _POSE
 DESCRIPTOR.message_types_by_name['Point'] = _POINT
 DESCRIPTOR.message_types_by_name['Depth'] = _DEPTH
+DESCRIPTOR.message_types_by_name['PackedDepth'] = _PACKEDDEPTH
 DESCRIPTOR.message_types_by_name['Row'] = _ROW
 
 CreateProjectRequest = _reflection.GeneratedProtocolMessageType('CreateProjectRequest', (_message.Message,), dict(
@@ -366,6 +1704,20 @@ AddResponse = _reflection.GeneratedProtocolMessageType('AddResponse', (_message.
   ))
 _sym_db.RegisterMessage(AddResponse)
 
+AddStreamRequest = _reflection.GeneratedProtocolMessageType('AddStreamRequest', (_message.Message,), dict(
+  DESCRIPTOR = _ADDSTREAMREQUEST,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:AddStreamRequest)
+  ))
+_sym_db.RegisterMessage(AddStreamRequest)
+
+AddStreamResponse = _reflection.GeneratedProtocolMessageType('AddStreamResponse', (_message.Message,), dict(
+  DESCRIPTOR = _ADDSTREAMRESPONSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:AddStreamResponse)
+  ))
+_sym_db.RegisterMessage(AddStreamResponse)
+
 RetrieveRequest = _reflection.GeneratedProtocolMessageType('RetrieveRequest', (_message.Message,), dict(
   DESCRIPTOR = _RETRIEVEREQUEST,
   __module__ = 'meshbuilder_pb2'
@@ -380,6 +1732,188 @@ RetrieveResponse = _reflection.GeneratedProtocolMessageType('RetrieveResponse',
   ))
 _sym_db.RegisterMessage(RetrieveResponse)
 
+RetrieveStreamRequest = _reflection.GeneratedProtocolMessageType('RetrieveStreamRequest', (_message.Message,), dict(
+  DESCRIPTOR = _RETRIEVESTREAMREQUEST,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:RetrieveStreamRequest)
+  ))
+_sym_db.RegisterMessage(RetrieveStreamRequest)
+
+RetrieveStreamResponse = _reflection.GeneratedProtocolMessageType('RetrieveStreamResponse', (_message.Message,), dict(
+  DESCRIPTOR = _RETRIEVESTREAMRESPONSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:RetrieveStreamResponse)
+  ))
+_sym_db.RegisterMessage(RetrieveStreamResponse)
+
+ProjectInfo = _reflection.GeneratedProtocolMessageType('ProjectInfo', (_message.Message,), dict(
+  DESCRIPTOR = _PROJECTINFO,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:ProjectInfo)
+  ))
+_sym_db.RegisterMessage(ProjectInfo)
+
+ListProjectsRequest = _reflection.GeneratedProtocolMessageType('ListProjectsRequest', (_message.Message,), dict(
+  DESCRIPTOR = _LISTPROJECTSREQUEST,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:ListProjectsRequest)
+  ))
+_sym_db.RegisterMessage(ListProjectsRequest)
+
+ListProjectsResponse = _reflection.GeneratedProtocolMessageType('ListProjectsResponse', (_message.Message,), dict(
+  DESCRIPTOR = _LISTPROJECTSRESPONSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:ListProjectsResponse)
+  ))
+_sym_db.RegisterMessage(ListProjectsResponse)
+
+GetProjectRequest = _reflection.GeneratedProtocolMessageType('GetProjectRequest', (_message.Message,), dict(
+  DESCRIPTOR = _GETPROJECTREQUEST,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:GetProjectRequest)
+  ))
+_sym_db.RegisterMessage(GetProjectRequest)
+
+GetProjectResponse = _reflection.GeneratedProtocolMessageType('GetProjectResponse', (_message.Message,), dict(
+  DESCRIPTOR = _GETPROJECTRESPONSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:GetProjectResponse)
+  ))
+_sym_db.RegisterMessage(GetProjectResponse)
+
+DeleteProjectRequest = _reflection.GeneratedProtocolMessageType('DeleteProjectRequest', (_message.Message,), dict(
+  DESCRIPTOR = _DELETEPROJECTREQUEST,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:DeleteProjectRequest)
+  ))
+_sym_db.RegisterMessage(DeleteProjectRequest)
+
+DeleteProjectResponse = _reflection.GeneratedProtocolMessageType('DeleteProjectResponse', (_message.Message,), dict(
+  DESCRIPTOR = _DELETEPROJECTRESPONSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:DeleteProjectResponse)
+  ))
+_sym_db.RegisterMessage(DeleteProjectResponse)
+
+RenameProjectRequest = _reflection.GeneratedProtocolMessageType('RenameProjectRequest', (_message.Message,), dict(
+  DESCRIPTOR = _RENAMEPROJECTREQUEST,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:RenameProjectRequest)
+  ))
+_sym_db.RegisterMessage(RenameProjectRequest)
+
+RenameProjectResponse = _reflection.GeneratedProtocolMessageType('RenameProjectResponse', (_message.Message,), dict(
+  DESCRIPTOR = _RENAMEPROJECTRESPONSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:RenameProjectResponse)
+  ))
+_sym_db.RegisterMessage(RenameProjectResponse)
+
+ClearProjectRequest = _reflection.GeneratedProtocolMessageType('ClearProjectRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CLEARPROJECTREQUEST,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:ClearProjectRequest)
+  ))
+_sym_db.RegisterMessage(ClearProjectRequest)
+
+ClearProjectResponse = _reflection.GeneratedProtocolMessageType('ClearProjectResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CLEARPROJECTRESPONSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:ClearProjectResponse)
+  ))
+_sym_db.RegisterMessage(ClearProjectResponse)
+
+SetPreprocessingRequest = _reflection.GeneratedProtocolMessageType('SetPreprocessingRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SETPREPROCESSINGREQUEST,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:SetPreprocessingRequest)
+  ))
+_sym_db.RegisterMessage(SetPreprocessingRequest)
+
+SetPreprocessingResponse = _reflection.GeneratedProtocolMessageType('SetPreprocessingResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SETPREPROCESSINGRESPONSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:SetPreprocessingResponse)
+  ))
+_sym_db.RegisterMessage(SetPreprocessingResponse)
+
+Preprocessing = _reflection.GeneratedProtocolMessageType('Preprocessing', (_message.Message,), dict(
+  DESCRIPTOR = _PREPROCESSING,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:Preprocessing)
+  ))
+_sym_db.RegisterMessage(Preprocessing)
+
+StatisticalOutlierRemoval = _reflection.GeneratedProtocolMessageType('StatisticalOutlierRemoval', (_message.Message,), dict(
+  DESCRIPTOR = _STATISTICALOUTLIERREMOVAL,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:StatisticalOutlierRemoval)
+  ))
+_sym_db.RegisterMessage(StatisticalOutlierRemoval)
+
+RadiusOutlierRemoval = _reflection.GeneratedProtocolMessageType('RadiusOutlierRemoval', (_message.Message,), dict(
+  DESCRIPTOR = _RADIUSOUTLIERREMOVAL,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:RadiusOutlierRemoval)
+  ))
+_sym_db.RegisterMessage(RadiusOutlierRemoval)
+
+DepthFilter = _reflection.GeneratedProtocolMessageType('DepthFilter', (_message.Message,), dict(
+  DESCRIPTOR = _DEPTHFILTER,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:DepthFilter)
+  ))
+_sym_db.RegisterMessage(DepthFilter)
+
+BilateralFilter = _reflection.GeneratedProtocolMessageType('BilateralFilter', (_message.Message,), dict(
+  DESCRIPTOR = _BILATERALFILTER,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:BilateralFilter)
+  ))
+_sym_db.RegisterMessage(BilateralFilter)
+
+MedianFilter = _reflection.GeneratedProtocolMessageType('MedianFilter', (_message.Message,), dict(
+  DESCRIPTOR = _MEDIANFILTER,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:MedianFilter)
+  ))
+_sym_db.RegisterMessage(MedianFilter)
+
+FlyingPixelFilter = _reflection.GeneratedProtocolMessageType('FlyingPixelFilter', (_message.Message,), dict(
+  DESCRIPTOR = _FLYINGPIXELFILTER,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:FlyingPixelFilter)
+  ))
+_sym_db.RegisterMessage(FlyingPixelFilter)
+
+HoleFilling = _reflection.GeneratedProtocolMessageType('HoleFilling', (_message.Message,), dict(
+  DESCRIPTOR = _HOLEFILLING,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:HoleFilling)
+  ))
+_sym_db.RegisterMessage(HoleFilling)
+
+Frame = _reflection.GeneratedProtocolMessageType('Frame', (_message.Message,), dict(
+  DESCRIPTOR = _FRAME,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:Frame)
+  ))
+_sym_db.RegisterMessage(Frame)
+
+Registration = _reflection.GeneratedProtocolMessageType('Registration', (_message.Message,), dict(
+  DESCRIPTOR = _REGISTRATION,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:Registration)
+  ))
+_sym_db.RegisterMessage(Registration)
+
+Pose = _reflection.GeneratedProtocolMessageType('Pose', (_message.Message,), dict(
+  DESCRIPTOR = _POSE,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:Pose)
+  ))
+_sym_db.RegisterMessage(Pose)
+
 Point = _reflection.GeneratedProtocolMessageType('Point', (_message.Message,), dict(
   DESCRIPTOR = _POINT,
   __module__ = 'meshbuilder_pb2'
@@ -394,6 +1928,13 @@ Depth = _reflection.GeneratedProtocolMessageType('Depth', (_message.Message,), d
   ))
 _sym_db.RegisterMessage(Depth)
 
+PackedDepth = _reflection.GeneratedProtocolMessageType('PackedDepth', (_message.Message,), dict(
+  DESCRIPTOR = _PACKEDDEPTH,
+  __module__ = 'meshbuilder_pb2'
+  # @@protoc_insertion_point(class_scope:PackedDepth)
+  ))
+_sym_db.RegisterMessage(PackedDepth)
+
 Row = _reflection.GeneratedProtocolMessageType('Row', (_message.Message,), dict(
   DESCRIPTOR = _ROW,
   __module__ = 'meshbuilder_pb2'
@@ -435,6 +1976,46 @@ try:
           request_serializer=RetrieveRequest.SerializeToString,
           response_deserializer=RetrieveResponse.FromString,
           )
+      self.AddStream = channel.stream_unary(
+          '/MeshBuilder/AddStream',
+          request_serializer=AddStreamRequest.SerializeToString,
+          response_deserializer=AddStreamResponse.FromString,
+          )
+      self.RetrieveStream = channel.unary_stream(
+          '/MeshBuilder/RetrieveStream',
+          request_serializer=RetrieveStreamRequest.SerializeToString,
+          response_deserializer=RetrieveStreamResponse.FromString,
+          )
+      self.ListProjects = channel.unary_unary(
+          '/MeshBuilder/ListProjects',
+          request_serializer=ListProjectsRequest.SerializeToString,
+          response_deserializer=ListProjectsResponse.FromString,
+          )
+      self.GetProject = channel.unary_unary(
+          '/MeshBuilder/GetProject',
+          request_serializer=GetProjectRequest.SerializeToString,
+          response_deserializer=GetProjectResponse.FromString,
+          )
+      self.DeleteProject = channel.unary_unary(
+          '/MeshBuilder/DeleteProject',
+          request_serializer=DeleteProjectRequest.SerializeToString,
+          response_deserializer=DeleteProjectResponse.FromString,
+          )
+      self.RenameProject = channel.unary_unary(
+          '/MeshBuilder/RenameProject',
+          request_serializer=RenameProjectRequest.SerializeToString,
+          response_deserializer=RenameProjectResponse.FromString,
+          )
+      self.ClearProject = channel.unary_unary(
+          '/MeshBuilder/ClearProject',
+          request_serializer=ClearProjectRequest.SerializeToString,
+          response_deserializer=ClearProjectResponse.FromString,
+          )
+      self.SetPreprocessing = channel.unary_unary(
+          '/MeshBuilder/SetPreprocessing',
+          request_serializer=SetPreprocessingRequest.SerializeToString,
+          response_deserializer=SetPreprocessingResponse.FromString,
+          )
 
 
   class MeshBuilderServicer(object):
@@ -445,6 +2026,10 @@ try:
       raise NotImplementedError('Method not implemented!')
 
     def Add(self, request, context):
+      """Fails with INVALID_ARGUMENT, with a BadRequest detail naming the field at
+      fault, if the frame can't be processed, and with RESOURCE_EXHAUSTED if
+      the project is too large to add the frame to.
+      """
       context.set_code(grpc.StatusCode.UNIMPLEMENTED)
       context.set_details('Method not implemented!')
       raise NotImplementedError('Method not implemented!')
@@ -454,6 +2039,66 @@ try:
       context.set_details('Method not implemented!')
       raise NotImplementedError('Method not implemented!')
 
+    def AddStream(self, request_iterator, context):
+      """Adds a stream of frames to a single project, which is named by the first
+      request in the stream. Frames are added in the order they're received.
+      Any frame whose sequence number isn't greater than that of the last
+      frame added is dropped, as are frames that can't be processed. The
+      stream fails if the project becomes too large to add a frame to.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def RetrieveStream(self, request, context):
+      """Streams a project's points in chunks, so that large projects don't
+      exceed gRPC's message size limit. With watch set, the stream stays open
+      after the existing points are sent, and points from frames added later
+      are streamed as they arrive until the client cancels.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def ListProjects(self, request, context):
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def GetProject(self, request, context):
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def DeleteProject(self, request, context):
+      """Deletes a project and everything stored for it.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def RenameProject(self, request, context):
+      """Renames a project. Fails if a project with the new name already exists.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def ClearProject(self, request, context):
+      """Removes every frame and point from a project, but keeps the project.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
+    def SetPreprocessing(self, request, context):
+      """Changes how frames added to a project from now on are preprocessed.
+      Frames already in the project are left as they are.
+      """
+      context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+      context.set_details('Method not implemented!')
+      raise NotImplementedError('Method not implemented!')
+
 
   def add_MeshBuilderServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -472,6 +2117,46 @@ try:
             request_deserializer=RetrieveRequest.FromString,
             response_serializer=RetrieveResponse.SerializeToString,
         ),
+        'AddStream': grpc.stream_unary_rpc_method_handler(
+            servicer.AddStream,
+            request_deserializer=AddStreamRequest.FromString,
+            response_serializer=AddStreamResponse.SerializeToString,
+        ),
+        'RetrieveStream': grpc.unary_stream_rpc_method_handler(
+            servicer.RetrieveStream,
+            request_deserializer=RetrieveStreamRequest.FromString,
+            response_serializer=RetrieveStreamResponse.SerializeToString,
+        ),
+        'ListProjects': grpc.unary_unary_rpc_method_handler(
+            servicer.ListProjects,
+            request_deserializer=ListProjectsRequest.FromString,
+            response_serializer=ListProjectsResponse.SerializeToString,
+        ),
+        'GetProject': grpc.unary_unary_rpc_method_handler(
+            servicer.GetProject,
+            request_deserializer=GetProjectRequest.FromString,
+            response_serializer=GetProjectResponse.SerializeToString,
+        ),
+        'DeleteProject': grpc.unary_unary_rpc_method_handler(
+            servicer.DeleteProject,
+            request_deserializer=DeleteProjectRequest.FromString,
+            response_serializer=DeleteProjectResponse.SerializeToString,
+        ),
+        'RenameProject': grpc.unary_unary_rpc_method_handler(
+            servicer.RenameProject,
+            request_deserializer=RenameProjectRequest.FromString,
+            response_serializer=RenameProjectResponse.SerializeToString,
+        ),
+        'ClearProject': grpc.unary_unary_rpc_method_handler(
+            servicer.ClearProject,
+            request_deserializer=ClearProjectRequest.FromString,
+            response_serializer=ClearProjectResponse.SerializeToString,
+        ),
+        'SetPreprocessing': grpc.unary_unary_rpc_method_handler(
+            servicer.SetPreprocessing,
+            request_deserializer=SetPreprocessingRequest.FromString,
+            response_serializer=SetPreprocessingResponse.SerializeToString,
+        ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
         'MeshBuilder', rpc_method_handlers)
@@ -487,9 +2172,49 @@ try:
     def CreateProject(self, request, context):
       context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
     def Add(self, request, context):
+      """Fails with INVALID_ARGUMENT, with a BadRequest detail naming the field at
+      fault, if the frame can't be processed, and with RESOURCE_EXHAUSTED if
+      the project is too large to add the frame to.
+      """
       context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
     def Retrieve(self, request, context):
       context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def AddStream(self, request_iterator, context):
+      """Adds a stream of frames to a single project, which is named by the first
+      request in the stream. Frames are added in the order they're received.
+      Any frame whose sequence number isn't greater than that of the last
+      frame added is dropped, as are frames that can't be processed. The
+      stream fails if the project becomes too large to add a frame to.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def RetrieveStream(self, request, context):
+      """Streams a project's points in chunks, so that large projects don't
+      exceed gRPC's message size limit. With watch set, the stream stays open
+      after the existing points are sent, and points from frames added later
+      are streamed as they arrive until the client cancels.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def ListProjects(self, request, context):
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def GetProject(self, request, context):
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def DeleteProject(self, request, context):
+      """Deletes a project and everything stored for it.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def RenameProject(self, request, context):
+      """Renames a project. Fails if a project with the new name already exists.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def ClearProject(self, request, context):
+      """Removes every frame and point from a project, but keeps the project.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+    def SetPreprocessing(self, request, context):
+      """Changes how frames added to a project from now on are preprocessed.
+      Frames already in the project are left as they are.
+      """
+      context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
 
 
   class BetaMeshBuilderStub(object):
@@ -502,11 +2227,58 @@ try:
       raise NotImplementedError()
     CreateProject.future = None
     def Add(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """Fails with INVALID_ARGUMENT, with a BadRequest detail naming the field at
+      fault, if the frame can't be processed, and with RESOURCE_EXHAUSTED if
+      the project is too large to add the frame to.
+      """
       raise NotImplementedError()
     Add.future = None
     def Retrieve(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
       raise NotImplementedError()
     Retrieve.future = None
+    def AddStream(self, request_iterator, timeout, metadata=None, with_call=False, protocol_options=None):
+      """Adds a stream of frames to a single project, which is named by the first
+      request in the stream. Frames are added in the order they're received.
+      Any frame whose sequence number isn't greater than that of the last
+      frame added is dropped, as are frames that can't be processed. The
+      stream fails if the project becomes too large to add a frame to.
+      """
+      raise NotImplementedError()
+    AddStream.future = None
+    def RetrieveStream(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """Streams a project's points in chunks, so that large projects don't
+      exceed gRPC's message size limit. With watch set, the stream stays open
+      after the existing points are sent, and points from frames added later
+      are streamed as they arrive until the client cancels.
+      """
+      raise NotImplementedError()
+    def ListProjects(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      raise NotImplementedError()
+    ListProjects.future = None
+    def GetProject(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      raise NotImplementedError()
+    GetProject.future = None
+    def DeleteProject(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """Deletes a project and everything stored for it.
+      """
+      raise NotImplementedError()
+    DeleteProject.future = None
+    def RenameProject(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """Renames a project. Fails if a project with the new name already exists.
+      """
+      raise NotImplementedError()
+    RenameProject.future = None
+    def ClearProject(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """Removes every frame and point from a project, but keeps the project.
+      """
+      raise NotImplementedError()
+    ClearProject.future = None
+    def SetPreprocessing(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+      """Changes how frames added to a project from now on are preprocessed.
+      Frames already in the project are left as they are.
+      """
+      raise NotImplementedError()
+    SetPreprocessing.future = None
 
 
   def beta_create_MeshBuilder_server(servicer, pool=None, pool_size=None, default_timeout=None, maximum_timeout=None):
@@ -517,18 +2289,42 @@ try:
     generated only to ease transition from grpcio<0.15.0 to grpcio>=0.15.0"""
     request_deserializers = {
       ('MeshBuilder', 'Add'): AddRequest.FromString,
+      ('MeshBuilder', 'AddStream'): AddStreamRequest.FromString,
+      ('MeshBuilder', 'ClearProject'): ClearProjectRequest.FromString,
       ('MeshBuilder', 'CreateProject'): CreateProjectRequest.FromString,
+      ('MeshBuilder', 'DeleteProject'): DeleteProjectRequest.FromString,
+      ('MeshBuilder', 'GetProject'): GetProjectRequest.FromString,
+      ('MeshBuilder', 'ListProjects'): ListProjectsRequest.FromString,
+      ('MeshBuilder', 'RenameProject'): RenameProjectRequest.FromString,
       ('MeshBuilder', 'Retrieve'): RetrieveRequest.FromString,
+      ('MeshBuilder', 'RetrieveStream'): RetrieveStreamRequest.FromString,
+      ('MeshBuilder', 'SetPreprocessing'): SetPreprocessingRequest.FromString,
     }
     response_serializers = {
       ('MeshBuilder', 'Add'): AddResponse.SerializeToString,
+      ('MeshBuilder', 'AddStream'): AddStreamResponse.SerializeToString,
+      ('MeshBuilder', 'ClearProject'): ClearProjectResponse.SerializeToString,
       ('MeshBuilder', 'CreateProject'): CreateProjectResponse.SerializeToString,
+      ('MeshBuilder', 'DeleteProject'): DeleteProjectResponse.SerializeToString,
+      ('MeshBuilder', 'GetProject'): GetProjectResponse.SerializeToString,
+      ('MeshBuilder', 'ListProjects'): ListProjectsResponse.SerializeToString,
+      ('MeshBuilder', 'RenameProject'): RenameProjectResponse.SerializeToString,
       ('MeshBuilder', 'Retrieve'): RetrieveResponse.SerializeToString,
+      ('MeshBuilder', 'RetrieveStream'): RetrieveStreamResponse.SerializeToString,
+      ('MeshBuilder', 'SetPreprocessing'): SetPreprocessingResponse.SerializeToString,
     }
     method_implementations = {
       ('MeshBuilder', 'Add'): face_utilities.unary_unary_inline(servicer.Add),
+      ('MeshBuilder', 'AddStream'): face_utilities.stream_unary_inline(servicer.AddStream),
+      ('MeshBuilder', 'ClearProject'): face_utilities.unary_unary_inline(servicer.ClearProject),
       ('MeshBuilder', 'CreateProject'): face_utilities.unary_unary_inline(servicer.CreateProject),
+      ('MeshBuilder', 'DeleteProject'): face_utilities.unary_unary_inline(servicer.DeleteProject),
+      ('MeshBuilder', 'GetProject'): face_utilities.unary_unary_inline(servicer.GetProject),
+      ('MeshBuilder', 'ListProjects'): face_utilities.unary_unary_inline(servicer.ListProjects),
+      ('MeshBuilder', 'RenameProject'): face_utilities.unary_unary_inline(servicer.RenameProject),
       ('MeshBuilder', 'Retrieve'): face_utilities.unary_unary_inline(servicer.Retrieve),
+      ('MeshBuilder', 'RetrieveStream'): face_utilities.unary_stream_inline(servicer.RetrieveStream),
+      ('MeshBuilder', 'SetPreprocessing'): face_utilities.unary_unary_inline(servicer.SetPreprocessing),
     }
     server_options = beta_implementations.server_options(request_deserializers=request_deserializers, response_serializers=response_serializers, thread_pool=pool, thread_pool_size=pool_size, default_timeout=default_timeout, maximum_timeout=maximum_timeout)
     return beta_implementations.server(method_implementations, options=server_options)
@@ -542,18 +2338,42 @@ try:
     generated only to ease transition from grpcio<0.15.0 to grpcio>=0.15.0"""
     request_serializers = {
       ('MeshBuilder', 'Add'): AddRequest.SerializeToString,
+      ('MeshBuilder', 'AddStream'): AddStreamRequest.SerializeToString,
+      ('MeshBuilder', 'ClearProject'): ClearProjectRequest.SerializeToString,
       ('MeshBuilder', 'CreateProject'): CreateProjectRequest.SerializeToString,
+      ('MeshBuilder', 'DeleteProject'): DeleteProjectRequest.SerializeToString,
+      ('MeshBuilder', 'GetProject'): GetProjectRequest.SerializeToString,
+      ('MeshBuilder', 'ListProjects'): ListProjectsRequest.SerializeToString,
+      ('MeshBuilder', 'RenameProject'): RenameProjectRequest.SerializeToString,
       ('MeshBuilder', 'Retrieve'): RetrieveRequest.SerializeToString,
+      ('MeshBuilder', 'RetrieveStream'): RetrieveStreamRequest.SerializeToString,
+      ('MeshBuilder', 'SetPreprocessing'): SetPreprocessingRequest.SerializeToString,
     }
     response_deserializers = {
       ('MeshBuilder', 'Add'): AddResponse.FromString,
+      ('MeshBuilder', 'AddStream'): AddStreamResponse.FromString,
+      ('MeshBuilder', 'ClearProject'): ClearProjectResponse.FromString,
       ('MeshBuilder', 'CreateProject'): CreateProjectResponse.FromString,
+      ('MeshBuilder', 'DeleteProject'): DeleteProjectResponse.FromString,
+      ('MeshBuilder', 'GetProject'): GetProjectResponse.FromString,
+      ('MeshBuilder', 'ListProjects'): ListProjectsResponse.FromString,
+      ('MeshBuilder', 'RenameProject'): RenameProjectResponse.FromString,
       ('MeshBuilder', 'Retrieve'): RetrieveResponse.FromString,
+      ('MeshBuilder', 'RetrieveStream'): RetrieveStreamResponse.FromString,
+      ('MeshBuilder', 'SetPreprocessing'): SetPreprocessingResponse.FromString,
     }
     cardinalities = {
       'Add': cardinality.Cardinality.UNARY_UNARY,
+      'AddStream': cardinality.Cardinality.STREAM_UNARY,
+      'ClearProject': cardinality.Cardinality.UNARY_UNARY,
       'CreateProject': cardinality.Cardinality.UNARY_UNARY,
+      'DeleteProject': cardinality.Cardinality.UNARY_UNARY,
+      'GetProject': cardinality.Cardinality.UNARY_UNARY,
+      'ListProjects': cardinality.Cardinality.UNARY_UNARY,
+      'RenameProject': cardinality.Cardinality.UNARY_UNARY,
       'Retrieve': cardinality.Cardinality.UNARY_UNARY,
+      'RetrieveStream': cardinality.Cardinality.UNARY_STREAM,
+      'SetPreprocessing': cardinality.Cardinality.UNARY_UNARY,
     }
     stub_options = beta_implementations.stub_options(host=host, metadata_transformer=metadata_transformer, request_serializers=request_serializers, response_deserializers=response_deserializers, thread_pool=pool, thread_pool_size=pool_size)
     return beta_implementations.dynamic_stub(channel, 'MeshBuilder', cardinalities, options=stub_options)
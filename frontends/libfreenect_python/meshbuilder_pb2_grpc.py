@@ -29,6 +29,46 @@ class MeshBuilderStub(object):
         request_serializer=meshbuilder__pb2.RetrieveRequest.SerializeToString,
         response_deserializer=meshbuilder__pb2.RetrieveResponse.FromString,
         )
+    self.AddStream = channel.stream_unary(
+        '/MeshBuilder/AddStream',
+        request_serializer=meshbuilder__pb2.AddStreamRequest.SerializeToString,
+        response_deserializer=meshbuilder__pb2.AddStreamResponse.FromString,
+        )
+    self.RetrieveStream = channel.unary_stream(
+        '/MeshBuilder/RetrieveStream',
+        request_serializer=meshbuilder__pb2.RetrieveStreamRequest.SerializeToString,
+        response_deserializer=meshbuilder__pb2.RetrieveStreamResponse.FromString,
+        )
+    self.ListProjects = channel.unary_unary(
+        '/MeshBuilder/ListProjects',
+        request_serializer=meshbuilder__pb2.ListProjectsRequest.SerializeToString,
+        response_deserializer=meshbuilder__pb2.ListProjectsResponse.FromString,
+        )
+    self.GetProject = channel.unary_unary(
+        '/MeshBuilder/GetProject',
+        request_serializer=meshbuilder__pb2.GetProjectRequest.SerializeToString,
+        response_deserializer=meshbuilder__pb2.GetProjectResponse.FromString,
+        )
+    self.DeleteProject = channel.unary_unary(
+        '/MeshBuilder/DeleteProject',
+        request_serializer=meshbuilder__pb2.DeleteProjectRequest.SerializeToString,
+        response_deserializer=meshbuilder__pb2.DeleteProjectResponse.FromString,
+        )
+    self.RenameProject = channel.unary_unary(
+        '/MeshBuilder/RenameProject',
+        request_serializer=meshbuilder__pb2.RenameProjectRequest.SerializeToString,
+        response_deserializer=meshbuilder__pb2.RenameProjectResponse.FromString,
+        )
+    self.ClearProject = channel.unary_unary(
+        '/MeshBuilder/ClearProject',
+        request_serializer=meshbuilder__pb2.ClearProjectRequest.SerializeToString,
+        response_deserializer=meshbuilder__pb2.ClearProjectResponse.FromString,
+        )
+    self.SetPreprocessing = channel.unary_unary(
+        '/MeshBuilder/SetPreprocessing',
+        request_serializer=meshbuilder__pb2.SetPreprocessingRequest.SerializeToString,
+        response_deserializer=meshbuilder__pb2.SetPreprocessingResponse.FromString,
+        )
 
 
 class MeshBuilderServicer(object):
@@ -39,6 +79,10 @@ class MeshBuilderServicer(object):
     raise NotImplementedError('Method not implemented!')
 
   def Add(self, request, context):
+    """Fails with INVALID_ARGUMENT, with a BadRequest detail naming the field at
+    fault, if the frame can't be processed, and with RESOURCE_EXHAUSTED if
+    the project is too large to add the frame to.
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
@@ -48,6 +92,66 @@ class MeshBuilderServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def AddStream(self, request_iterator, context):
+    """Adds a stream of frames to a single project, which is named by the first
+    request in the stream. Frames are added in the order they're received.
+    Any frame whose sequence number isn't greater than that of the last
+    frame added is dropped, as are frames that can't be processed. The
+    stream fails if the project becomes too large to add a frame to.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RetrieveStream(self, request, context):
+    """Streams a project's points in chunks, so that large projects don't
+    exceed gRPC's message size limit. With watch set, the stream stays open
+    after the existing points are sent, and points from frames added later
+    are streamed as they arrive until the client cancels.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListProjects(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetProject(self, request, context):
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def DeleteProject(self, request, context):
+    """Deletes a project and everything stored for it.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def RenameProject(self, request, context):
+    """Renames a project. Fails if a project with the new name already exists.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ClearProject(self, request, context):
+    """Removes every frame and point from a project, but keeps the project.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SetPreprocessing(self, request, context):
+    """Changes how frames added to a project from now on are preprocessed.
+    Frames already in the project are left as they are.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_MeshBuilderServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -66,6 +170,46 @@ def add_MeshBuilderServicer_to_server(servicer, server):
           request_deserializer=meshbuilder__pb2.RetrieveRequest.FromString,
           response_serializer=meshbuilder__pb2.RetrieveResponse.SerializeToString,
       ),
+      'AddStream': grpc.stream_unary_rpc_method_handler(
+          servicer.AddStream,
+          request_deserializer=meshbuilder__pb2.AddStreamRequest.FromString,
+          response_serializer=meshbuilder__pb2.AddStreamResponse.SerializeToString,
+      ),
+      'RetrieveStream': grpc.unary_stream_rpc_method_handler(
+          servicer.RetrieveStream,
+          request_deserializer=meshbuilder__pb2.RetrieveStreamRequest.FromString,
+          response_serializer=meshbuilder__pb2.RetrieveStreamResponse.SerializeToString,
+      ),
+      'ListProjects': grpc.unary_unary_rpc_method_handler(
+          servicer.ListProjects,
+          request_deserializer=meshbuilder__pb2.ListProjectsRequest.FromString,
+          response_serializer=meshbuilder__pb2.ListProjectsResponse.SerializeToString,
+      ),
+      'GetProject': grpc.unary_unary_rpc_method_handler(
+          servicer.GetProject,
+          request_deserializer=meshbuilder__pb2.GetProjectRequest.FromString,
+          response_serializer=meshbuilder__pb2.GetProjectResponse.SerializeToString,
+      ),
+      'DeleteProject': grpc.unary_unary_rpc_method_handler(
+          servicer.DeleteProject,
+          request_deserializer=meshbuilder__pb2.DeleteProjectRequest.FromString,
+          response_serializer=meshbuilder__pb2.DeleteProjectResponse.SerializeToString,
+      ),
+      'RenameProject': grpc.unary_unary_rpc_method_handler(
+          servicer.RenameProject,
+          request_deserializer=meshbuilder__pb2.RenameProjectRequest.FromString,
+          response_serializer=meshbuilder__pb2.RenameProjectResponse.SerializeToString,
+      ),
+      'ClearProject': grpc.unary_unary_rpc_method_handler(
+          servicer.ClearProject,
+          request_deserializer=meshbuilder__pb2.ClearProjectRequest.FromString,
+          response_serializer=meshbuilder__pb2.ClearProjectResponse.SerializeToString,
+      ),
+      'SetPreprocessing': grpc.unary_unary_rpc_method_handler(
+          servicer.SetPreprocessing,
+          request_deserializer=meshbuilder__pb2.SetPreprocessingRequest.FromString,
+          response_serializer=meshbuilder__pb2.SetPreprocessingResponse.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'MeshBuilder', rpc_method_handlers)
@@ -13,10 +13,9 @@ def get_video():
   array = cv2.cvtColor(array,cv2.COLOR_RGB2BGR)
   return array
 
-#function to get depth image from kinect
+#function to get raw 11-bit disparity from kinect
 def get_depth():
   array,_ = freenect.sync_get_depth()
-  array = array.astype(np.uint8)
   return array
 
 def main():
@@ -35,9 +34,13 @@ def main():
   # Create the project (if it doesn't already exist) and get the project ID.
   create_project_proto = meshbuilder_pb2.CreateProjectRequest()
   create_project_proto.name = project_name
-  stub.CreateProject(create_project_proto)
+  create_project_proto.get_or_create = True
+  response = stub.CreateProject(create_project_proto)
   
-  print("Project created!.")
+  if response.created:
+    print("Project created! ID: {0}".format(response.id))
+  else:
+    print("Resuming project with {0} frames. ID: {1}".format(response.project.frame_count, response.id))
 
   depth = get_depth()
 
@@ -47,7 +50,7 @@ def main():
     #get a frame from depth sensor
     depth = get_depth()
     #display depth image
-    cv2.imshow('stream',depth)
+    cv2.imshow('stream',depth.astype(np.uint8))
 
   first_point_cloud = depth
   
@@ -59,6 +62,7 @@ def main():
     new_row.values[:] = row
   proto.depth.x_fov = 58.5
   proto.depth.y_fov = 46.6
+  proto.depth.encoding = meshbuilder_pb2.Depth.KINECT_DISPARITY
   
   # Start GRPC
   frames.append(stub.Add.future(proto))
@@ -67,7 +71,7 @@ def main():
     #get a frame from depth sensor
     depth = get_depth()
     #display depth image
-    cv2.imshow('stream',depth)
+    cv2.imshow('stream',depth.astype(np.uint8))
 
   second_point_cloud = depth
   
@@ -79,6 +83,7 @@ def main():
     new_row.values[:] = row
   proto.depth.x_fov = 58.5
   proto.depth.y_fov = 46.6
+  proto.depth.encoding = meshbuilder_pb2.Depth.KINECT_DISPARITY
 
   # Start GRPC
   frames.append(stub.Add.future(proto))
@@ -3,11 +3,13 @@ package main
 import (
 	"flag"
 	"image/color"
+	"math"
 
 	"log"
 	"os"
 	"time"
 
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"net/http"
 	_ "net/http/pprof"
@@ -46,6 +48,16 @@ var (
 	frameRate = flag.Duration("framerate", time.Second/60, `Cap on framerate. Provide with units, like "16.66ms"`)
 	baseDir   = flag.String("base_dir", `C:\workspace\Go\src\github.com\omustardo\scanner\frontends\modelviewer`, "All file paths should be specified relative to this root.")
 	//cpuprofile = flag.String("cpuprofile", "cpu.prof", "write cpu profile `file`")
+
+	minDepth = flag.Float64("min_depth", 0.5, "Depth in meters of an 8-bit value of 1, for frames recorded without an encoding, like the ones in base_dir.")
+	maxDepth = flag.Float64("max_depth", 4.5, "Depth in meters of an 8-bit value of 255, for frames recorded without an encoding.")
+
+	lfshRadius         = flag.Float64("lfsh_radius", points.DefaultLFSHOptions().Radius, "Radius of the neighborhood used for each point's descriptor, in meters.")
+	lfshDepthBuckets   = flag.Int("lfsh_depth_buckets", points.DefaultLFSHOptions().DepthBuckets, "Number of buckets in the descriptor's local depth histogram.")
+	lfshAngularBuckets = flag.Int("lfsh_angular_buckets", points.DefaultLFSHOptions().AngularBuckets, "Number of buckets in the descriptor's normal deviance histogram.")
+	lfshAnnuli         = flag.Int("lfsh_annuli", points.DefaultLFSHOptions().Annuli, "Number of buckets in the descriptor's radial density histogram.")
+	minNeighbors       = flag.Int("min_neighbors", points.DefaultLFSHOptions().MinNeighbors, "Points with fewer neighbors than this get no descriptor, and are drawn black.")
+	propagateNormals   = flag.Bool("propagate_normals", false, "Whether to orient normals by propagating across the cloud rather than toward the sensor. Useful for clouds merged from several frames.")
 )
 
 func init() {
@@ -100,14 +112,31 @@ func main() {
 	// =========== Read points from File ===========
 	pointCloud := toVec3(fromFile(`1489724366`)) // 1489724360 1489724366
 	log.Printf("got %d points, storing in texture of size %d\n", len(pointCloud), util.RoundUpToPowerOfTwo(len(pointCloud)))
+	orientation := points.OrientTowardViewpoint
+	if *propagateNormals {
+		orientation = points.OrientByPropagation
+	}
 	p := &points.PointCloudAnalyzer{}
-	p.MakePointCloudAnalyzer(cloudToDense(pointCloud))
+	if err := p.MakePointCloudAnalyzerWithOptions(cloudToDense(pointCloud), points.LFSHOptions{
+		Radius:         *lfshRadius,
+		DepthBuckets:   *lfshDepthBuckets,
+		AngularBuckets: *lfshAngularBuckets,
+		Annuli:         *lfshAnnuli,
+		Orientation:    orientation,
+		MinNeighbors:   *minNeighbors,
+	}); err != nil {
+		log.Fatal(err)
+	}
 	texData := make([][]uint8, 0, util.RoundUpToPowerOfTwo(len(pointCloud)))
 	texCoords := make([]mgl32.Vec2, 0, util.RoundUpToPowerOfTwo(len(pointCloud)))
-	for i := range pointCloud {
-		log.Println(i, len(pointCloud))
-		desc := p.Descriptor(i)
-		c := desc.VisualizeDescriptor()
+	start := time.Now()
+	descriptors, err := p.Descriptors(context.Background(), nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("computed %d descriptors in %v\n", len(descriptors), time.Since(start))
+	for i := range descriptors {
+		c := descriptors[i].VisualizeDescriptor()
 		texData = append(texData, []uint8{c.R, c.G, c.B, c.A})
 		texCoords = append(texCoords, mgl32.Vec2{float32(i), 0})
 	}
@@ -213,22 +242,78 @@ func fromFile(path string) []*meshbuilder.Point {
 	return processDepth(depth)
 }
 
+// processDepth returns the camera space points, in meters, of a depth frame
+// sent as rows. Points are deprojected the same way the server does it, so
+// that descriptor radii mean the same thing here as there.
 func processDepth(depth *meshbuilder.Depth) []*meshbuilder.Point {
+	toMeters := converterFor(depth)
+	height := len(depth.Rows)
+	if height == 0 {
+		panic("no rows in depth frame")
+	}
+	width := len(depth.Rows[0].GetValues())
+	if depth.Encoding == meshbuilder.Depth_METERS {
+		width = len(depth.Rows[0].GetFloatValues())
+	}
+	// Pinhole camera looking down +Z with +X to the right and +Y up, with its
+	// focal lengths in pixels derived from the field of view.
+	fx := float64(width) / 2 / math.Tan(float64(depth.XFov)*math.Pi/180/2)
+	fy := float64(height) / 2 / math.Tan(float64(depth.YFov)*math.Pi/180/2)
+	cx, cy := float64(width-1)/2, float64(height-1)/2
+
 	p := []*meshbuilder.Point{}
-	for row := range depth.Rows {
-		if depth.Rows[row] == nil {
+	for row, r := range depth.Rows {
+		if r == nil {
 			continue
 		}
-		for col, value := range depth.Rows[row].Values {
-			p = append(p, &meshbuilder.Point{X: float32(row), Y: float32(col), Z: float32(value)})
+		values := r.GetFloatValues()
+		if depth.Encoding != meshbuilder.Depth_METERS {
+			values = make([]float32, len(r.GetValues()))
+			for i, v := range r.GetValues() {
+				values[i] = float32(v)
+			}
+		}
+		for col, value := range values {
+			z, ok := toMeters(float64(value))
+			if !ok {
+				continue
+			}
+			x := (float64(col) - cx) * z / fx
+			y := (cy - float64(row)) * z / fy
+			p = append(p, &meshbuilder.Point{X: float32(x), Y: float32(y), Z: float32(z)})
 		}
 	}
 	if len(p) == 0 {
-		panic("foo")
+		panic("no valid depths in depth frame")
 	}
 	return p
 }
 
+// converterFor returns a function that turns the frame's raw values into
+// meters, like the server's converters. ok is false for pixels without a
+// reading.
+func converterFor(depth *meshbuilder.Depth) func(raw float64) (meters float64, ok bool) {
+	switch depth.Encoding {
+	case meshbuilder.Depth_MILLIMETERS:
+		return func(raw float64) (float64, bool) { return raw / 1000, raw > 0 }
+	case meshbuilder.Depth_METERS:
+		return func(raw float64) (float64, bool) { return raw, raw > 0 && !math.IsInf(raw, 1) }
+	case meshbuilder.Depth_KINECT_DISPARITY:
+		// http://nicolas.burrus.name/index.php/Research/KinectCalibration
+		return func(raw float64) (float64, bool) {
+			inverseDepth := raw*-0.0030711016 + 3.3309495161
+			return 1 / inverseDepth, raw > 0 && raw < 2047 && inverseDepth >= 1.0/8
+		}
+	}
+	min, max := *minDepth, *maxDepth
+	if depth.Encoding == meshbuilder.Depth_NORMALIZED_8BIT {
+		min, max = float64(depth.MinDepth), float64(depth.MaxDepth)
+	}
+	return func(raw float64) (float64, bool) {
+		return min + (raw-1)/254*(max-min), raw > 0 && raw <= 255
+	}
+}
+
 func cloudToDense(vecs []mgl32.Vec3) *mat64.Dense {
 	data := make([]float64, 0, 3*len(vecs))
 	for _, v := range vecs {
@@ -240,5 +325,5 @@ func cloudToDense(vecs []mgl32.Vec3) *mat64.Dense {
 	for _, v := range vecs {
 		data = append(data, float64(v.Z()))
 	}
-	return mat64.NewDense(3, 640*480, data)
+	return mat64.NewDense(3, len(vecs), data)
 }
@@ -13,10 +13,39 @@ It has these top-level messages:
 	CreateProjectResponse
 	AddRequest
 	AddResponse
+	AddStreamRequest
+	AddStreamResponse
 	RetrieveRequest
 	RetrieveResponse
+	RetrieveStreamRequest
+	RetrieveStreamResponse
+	ProjectInfo
+	ListProjectsRequest
+	ListProjectsResponse
+	GetProjectRequest
+	GetProjectResponse
+	DeleteProjectRequest
+	DeleteProjectResponse
+	RenameProjectRequest
+	RenameProjectResponse
+	ClearProjectRequest
+	ClearProjectResponse
+	SetPreprocessingRequest
+	SetPreprocessingResponse
+	Preprocessing
+	StatisticalOutlierRemoval
+	RadiusOutlierRemoval
+	DepthFilter
+	BilateralFilter
+	MedianFilter
+	FlyingPixelFilter
+	HoleFilling
+	Frame
+	Registration
+	Pose
 	Point
 	Depth
+	PackedDepth
 	Row
 */
 package meshbuilder
@@ -41,8 +70,82 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Depth_Encoding int32
+
+const (
+	// Frames must set an encoding. Ones that don't are rejected rather
+	// than guessed at.
+	Depth_ENCODING_UNSPECIFIED Depth_Encoding = 0
+	// Row.values holds depth in millimeters. 0 means no reading.
+	Depth_MILLIMETERS Depth_Encoding = 1
+	// Row.values holds raw 11-bit Kinect v1 disparity. 0 and 2047 mean
+	// no reading, as do disparities for depths beyond the sensor's 8 meter
+	// range.
+	Depth_KINECT_DISPARITY Depth_Encoding = 2
+	// Row.float_values holds depth in meters. 0 means no reading.
+	Depth_METERS Depth_Encoding = 3
+	// Row.values holds 8-bit values spread linearly from min_depth (1) to
+	// max_depth (255). 0 means no reading.
+	Depth_NORMALIZED_8BIT Depth_Encoding = 4
+)
+
+var Depth_Encoding_name = map[int32]string{
+	0: "ENCODING_UNSPECIFIED",
+	1: "MILLIMETERS",
+	2: "KINECT_DISPARITY",
+	3: "METERS",
+	4: "NORMALIZED_8BIT",
+}
+var Depth_Encoding_value = map[string]int32{
+	"ENCODING_UNSPECIFIED": 0,
+	"MILLIMETERS":          1,
+	"KINECT_DISPARITY":     2,
+	"METERS":               3,
+	"NORMALIZED_8BIT":      4,
+}
+
+func (x Depth_Encoding) String() string {
+	return proto.EnumName(Depth_Encoding_name, int32(x))
+}
+func (Depth_Encoding) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{35, 0} }
+
+type PackedDepth_Compression int32
+
+const (
+	// data holds width * height values in row major order. 16 bit values
+	// are little endian.
+	PackedDepth_NONE PackedDepth_Compression = 0
+	// data holds the NONE layout compressed with zlib (RFC 1950).
+	PackedDepth_ZLIB PackedDepth_Compression = 1
+	// data is a width by height grayscale PNG with bit_depth bits per
+	// pixel.
+	PackedDepth_PNG PackedDepth_Compression = 2
+)
+
+var PackedDepth_Compression_name = map[int32]string{
+	0: "NONE",
+	1: "ZLIB",
+	2: "PNG",
+}
+var PackedDepth_Compression_value = map[string]int32{
+	"NONE": 0,
+	"ZLIB": 1,
+	"PNG":  2,
+}
+
+func (x PackedDepth_Compression) String() string {
+	return proto.EnumName(PackedDepth_Compression_name, int32(x))
+}
+func (PackedDepth_Compression) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{36, 0} }
+
 type CreateProjectRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// If set, and a project named name already exists, it's returned instead
+	// of failing with ALREADY_EXISTS. Lets clients resume an existing scan.
+	GetOrCreate bool `protobuf:"varint,2,opt,name=get_or_create,json=getOrCreate" json:"get_or_create,omitempty"`
+	// Applied to every frame added to the project. Ignored if the project
+	// already exists.
+	Preprocessing *Preprocessing `protobuf:"bytes,3,opt,name=preprocessing" json:"preprocessing,omitempty"`
 }
 
 func (m *CreateProjectRequest) Reset()                    { *m = CreateProjectRequest{} }
@@ -57,7 +160,26 @@ func (m *CreateProjectRequest) GetName() string {
 	return ""
 }
 
+func (m *CreateProjectRequest) GetGetOrCreate() bool {
+	if m != nil {
+		return m.GetOrCreate
+	}
+	return false
+}
+
+func (m *CreateProjectRequest) GetPreprocessing() *Preprocessing {
+	if m != nil {
+		return m.Preprocessing
+	}
+	return nil
+}
+
 type CreateProjectResponse struct {
+	// Same as project.id.
+	Id      string       `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Project *ProjectInfo `protobuf:"bytes,2,opt,name=project" json:"project,omitempty"`
+	// False if get_or_create was set and the project already existed.
+	Created bool `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
 }
 
 func (m *CreateProjectResponse) Reset()                    { *m = CreateProjectResponse{} }
@@ -65,6 +187,27 @@ func (m *CreateProjectResponse) String() string            { return proto.Compac
 func (*CreateProjectResponse) ProtoMessage()               {}
 func (*CreateProjectResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
+func (m *CreateProjectResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateProjectResponse) GetProject() *ProjectInfo {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+func (m *CreateProjectResponse) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
 type AddRequest struct {
 	Name  string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Depth *Depth `protobuf:"bytes,2,opt,name=depth" json:"depth,omitempty"`
@@ -90,6 +233,7 @@ func (m *AddRequest) GetDepth() *Depth {
 }
 
 type AddResponse struct {
+	Registration *Registration `protobuf:"bytes,1,opt,name=registration" json:"registration,omitempty"`
 }
 
 func (m *AddResponse) Reset()                    { *m = AddResponse{} }
@@ -97,34 +241,943 @@ func (m *AddResponse) String() string            { return proto.CompactTextStrin
 func (*AddResponse) ProtoMessage()               {}
 func (*AddResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
+func (m *AddResponse) GetRegistration() *Registration {
+	if m != nil {
+		return m.Registration
+	}
+	return nil
+}
+
+type AddStreamRequest struct {
+	// Only read from the first request in a stream.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Should increase with each frame captured.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence" json:"sequence,omitempty"`
+	// Capture time in nanoseconds since the Unix epoch.
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	Depth     *Depth `protobuf:"bytes,4,opt,name=depth" json:"depth,omitempty"`
+}
+
+func (m *AddStreamRequest) Reset()                    { *m = AddStreamRequest{} }
+func (m *AddStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddStreamRequest) ProtoMessage()               {}
+func (*AddStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *AddStreamRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddStreamRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AddStreamRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AddStreamRequest) GetDepth() *Depth {
+	if m != nil {
+		return m.Depth
+	}
+	return nil
+}
+
+type AddStreamResponse struct {
+	FramesAccepted int64 `protobuf:"varint,1,opt,name=frames_accepted,json=framesAccepted" json:"frames_accepted,omitempty"`
+	FramesDropped  int64 `protobuf:"varint,2,opt,name=frames_dropped,json=framesDropped" json:"frames_dropped,omitempty"`
+	PointsAdded    int64 `protobuf:"varint,3,opt,name=points_added,json=pointsAdded" json:"points_added,omitempty"`
+}
+
+func (m *AddStreamResponse) Reset()                    { *m = AddStreamResponse{} }
+func (m *AddStreamResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddStreamResponse) ProtoMessage()               {}
+func (*AddStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *AddStreamResponse) GetFramesAccepted() int64 {
+	if m != nil {
+		return m.FramesAccepted
+	}
+	return 0
+}
+
+func (m *AddStreamResponse) GetFramesDropped() int64 {
+	if m != nil {
+		return m.FramesDropped
+	}
+	return 0
+}
+
+func (m *AddStreamResponse) GetPointsAdded() int64 {
+	if m != nil {
+		return m.PointsAdded
+	}
+	return 0
+}
+
 type RetrieveRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 }
 
-func (m *RetrieveRequest) Reset()                    { *m = RetrieveRequest{} }
-func (m *RetrieveRequest) String() string            { return proto.CompactTextString(m) }
-func (*RetrieveRequest) ProtoMessage()               {}
-func (*RetrieveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (m *RetrieveRequest) Reset()                    { *m = RetrieveRequest{} }
+func (m *RetrieveRequest) String() string            { return proto.CompactTextString(m) }
+func (*RetrieveRequest) ProtoMessage()               {}
+func (*RetrieveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *RetrieveRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RetrieveResponse struct {
+	Points []*Point `protobuf:"bytes,1,rep,name=points" json:"points,omitempty"`
+}
+
+func (m *RetrieveResponse) Reset()                    { *m = RetrieveResponse{} }
+func (m *RetrieveResponse) String() string            { return proto.CompactTextString(m) }
+func (*RetrieveResponse) ProtoMessage()               {}
+func (*RetrieveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *RetrieveResponse) GetPoints() []*Point {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type RetrieveStreamRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Most points to send in each response. If unset, the server picks.
+	MaxPoints int32 `protobuf:"varint,2,opt,name=max_points,json=maxPoints" json:"max_points,omitempty"`
+	Watch     bool  `protobuf:"varint,3,opt,name=watch" json:"watch,omitempty"`
+}
+
+func (m *RetrieveStreamRequest) Reset()                    { *m = RetrieveStreamRequest{} }
+func (m *RetrieveStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*RetrieveStreamRequest) ProtoMessage()               {}
+func (*RetrieveStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *RetrieveStreamRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RetrieveStreamRequest) GetMaxPoints() int32 {
+	if m != nil {
+		return m.MaxPoints
+	}
+	return 0
+}
+
+func (m *RetrieveStreamRequest) GetWatch() bool {
+	if m != nil {
+		return m.Watch
+	}
+	return false
+}
+
+type RetrieveStreamResponse struct {
+	Points []*Point `protobuf:"bytes,1,rep,name=points" json:"points,omitempty"`
+	// Number of points sent so far on this stream, including these.
+	PointsSent int64 `protobuf:"varint,2,opt,name=points_sent,json=pointsSent" json:"points_sent,omitempty"`
+	// Set on the last response of a batch, once the client has every point
+	// the project currently holds.
+	CaughtUp bool `protobuf:"varint,3,opt,name=caught_up,json=caughtUp" json:"caught_up,omitempty"`
+}
+
+func (m *RetrieveStreamResponse) Reset()                    { *m = RetrieveStreamResponse{} }
+func (m *RetrieveStreamResponse) String() string            { return proto.CompactTextString(m) }
+func (*RetrieveStreamResponse) ProtoMessage()               {}
+func (*RetrieveStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *RetrieveStreamResponse) GetPoints() []*Point {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func (m *RetrieveStreamResponse) GetPointsSent() int64 {
+	if m != nil {
+		return m.PointsSent
+	}
+	return 0
+}
+
+func (m *RetrieveStreamResponse) GetCaughtUp() bool {
+	if m != nil {
+		return m.CaughtUp
+	}
+	return false
+}
+
+// A summary of a project, without its points.
+type ProjectInfo struct {
+	Name       string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	FrameCount int64  `protobuf:"varint,2,opt,name=frame_count,json=frameCount" json:"frame_count,omitempty"`
+	PointCount int64  `protobuf:"varint,3,opt,name=point_count,json=pointCount" json:"point_count,omitempty"`
+	// Corners of the axis aligned box holding every point. Unset if the
+	// project has no points.
+	BoundsMin *Point `protobuf:"bytes,4,opt,name=bounds_min,json=boundsMin" json:"bounds_min,omitempty"`
+	BoundsMax *Point `protobuf:"bytes,5,opt,name=bounds_max,json=boundsMax" json:"bounds_max,omitempty"`
+	// Nanoseconds since the Unix epoch. updated is when a frame was last
+	// added or the project was cleared.
+	Created int64 `protobuf:"varint,6,opt,name=created" json:"created,omitempty"`
+	Updated int64 `protobuf:"varint,7,opt,name=updated" json:"updated,omitempty"`
+	// Assigned when the project is created. Unlike name, it never changes.
+	Id            string         `protobuf:"bytes,8,opt,name=id" json:"id,omitempty"`
+	Preprocessing *Preprocessing `protobuf:"bytes,9,opt,name=preprocessing" json:"preprocessing,omitempty"`
+}
+
+func (m *ProjectInfo) Reset()                    { *m = ProjectInfo{} }
+func (m *ProjectInfo) String() string            { return proto.CompactTextString(m) }
+func (*ProjectInfo) ProtoMessage()               {}
+func (*ProjectInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ProjectInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProjectInfo) GetFrameCount() int64 {
+	if m != nil {
+		return m.FrameCount
+	}
+	return 0
+}
+
+func (m *ProjectInfo) GetPointCount() int64 {
+	if m != nil {
+		return m.PointCount
+	}
+	return 0
+}
+
+func (m *ProjectInfo) GetBoundsMin() *Point {
+	if m != nil {
+		return m.BoundsMin
+	}
+	return nil
+}
+
+func (m *ProjectInfo) GetBoundsMax() *Point {
+	if m != nil {
+		return m.BoundsMax
+	}
+	return nil
+}
+
+func (m *ProjectInfo) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *ProjectInfo) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+func (m *ProjectInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProjectInfo) GetPreprocessing() *Preprocessing {
+	if m != nil {
+		return m.Preprocessing
+	}
+	return nil
+}
+
+type ListProjectsRequest struct {
+}
+
+func (m *ListProjectsRequest) Reset()                    { *m = ListProjectsRequest{} }
+func (m *ListProjectsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListProjectsRequest) ProtoMessage()               {}
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+type ListProjectsResponse struct {
+	// Sorted by name.
+	Projects []*ProjectInfo `protobuf:"bytes,1,rep,name=projects" json:"projects,omitempty"`
+}
+
+func (m *ListProjectsResponse) Reset()                    { *m = ListProjectsResponse{} }
+func (m *ListProjectsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListProjectsResponse) ProtoMessage()               {}
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ListProjectsResponse) GetProjects() []*ProjectInfo {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+type GetProjectRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *GetProjectRequest) Reset()                    { *m = GetProjectRequest{} }
+func (m *GetProjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetProjectRequest) ProtoMessage()               {}
+func (*GetProjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *GetProjectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetProjectResponse struct {
+	Project *ProjectInfo `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+}
+
+func (m *GetProjectResponse) Reset()                    { *m = GetProjectResponse{} }
+func (m *GetProjectResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetProjectResponse) ProtoMessage()               {}
+func (*GetProjectResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *GetProjectResponse) GetProject() *ProjectInfo {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *DeleteProjectRequest) Reset()                    { *m = DeleteProjectRequest{} }
+func (m *DeleteProjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteProjectRequest) ProtoMessage()               {}
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *DeleteProjectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteProjectResponse struct {
+}
+
+func (m *DeleteProjectResponse) Reset()                    { *m = DeleteProjectResponse{} }
+func (m *DeleteProjectResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteProjectResponse) ProtoMessage()               {}
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+type RenameProjectRequest struct {
+	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	NewName string `protobuf:"bytes,2,opt,name=new_name,json=newName" json:"new_name,omitempty"`
+}
+
+func (m *RenameProjectRequest) Reset()                    { *m = RenameProjectRequest{} }
+func (m *RenameProjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*RenameProjectRequest) ProtoMessage()               {}
+func (*RenameProjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *RenameProjectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RenameProjectRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+type RenameProjectResponse struct {
+}
+
+func (m *RenameProjectResponse) Reset()                    { *m = RenameProjectResponse{} }
+func (m *RenameProjectResponse) String() string            { return proto.CompactTextString(m) }
+func (*RenameProjectResponse) ProtoMessage()               {}
+func (*RenameProjectResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+type ClearProjectRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *ClearProjectRequest) Reset()                    { *m = ClearProjectRequest{} }
+func (m *ClearProjectRequest) String() string            { return proto.CompactTextString(m) }
+func (*ClearProjectRequest) ProtoMessage()               {}
+func (*ClearProjectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *ClearProjectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ClearProjectResponse struct {
+}
+
+func (m *ClearProjectResponse) Reset()                    { *m = ClearProjectResponse{} }
+func (m *ClearProjectResponse) String() string            { return proto.CompactTextString(m) }
+func (*ClearProjectResponse) ProtoMessage()               {}
+func (*ClearProjectResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+type SetPreprocessingRequest struct {
+	Name          string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Preprocessing *Preprocessing `protobuf:"bytes,2,opt,name=preprocessing" json:"preprocessing,omitempty"`
+}
+
+func (m *SetPreprocessingRequest) Reset()                    { *m = SetPreprocessingRequest{} }
+func (m *SetPreprocessingRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetPreprocessingRequest) ProtoMessage()               {}
+func (*SetPreprocessingRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *SetPreprocessingRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetPreprocessingRequest) GetPreprocessing() *Preprocessing {
+	if m != nil {
+		return m.Preprocessing
+	}
+	return nil
+}
+
+type SetPreprocessingResponse struct {
+}
+
+func (m *SetPreprocessingResponse) Reset()                    { *m = SetPreprocessingResponse{} }
+func (m *SetPreprocessingResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetPreprocessingResponse) ProtoMessage()               {}
+func (*SetPreprocessingResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+// How a project cleans up each frame before registering it and adding its
+// points. Each step is skipped if it's unset. Depth filters are applied first,
+// to the depth image, and the outlier removal steps then apply to the points
+// made from it.
+type Preprocessing struct {
+	StatisticalOutlierRemoval *StatisticalOutlierRemoval `protobuf:"bytes,1,opt,name=statistical_outlier_removal,json=statisticalOutlierRemoval" json:"statistical_outlier_removal,omitempty"`
+	RadiusOutlierRemoval      *RadiusOutlierRemoval      `protobuf:"bytes,2,opt,name=radius_outlier_removal,json=radiusOutlierRemoval" json:"radius_outlier_removal,omitempty"`
+	// Applied in order.
+	DepthFilters []*DepthFilter `protobuf:"bytes,3,rep,name=depth_filters,json=depthFilters" json:"depth_filters,omitempty"`
+}
+
+func (m *Preprocessing) Reset()                    { *m = Preprocessing{} }
+func (m *Preprocessing) String() string            { return proto.CompactTextString(m) }
+func (*Preprocessing) ProtoMessage()               {}
+func (*Preprocessing) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *Preprocessing) GetStatisticalOutlierRemoval() *StatisticalOutlierRemoval {
+	if m != nil {
+		return m.StatisticalOutlierRemoval
+	}
+	return nil
+}
+
+func (m *Preprocessing) GetRadiusOutlierRemoval() *RadiusOutlierRemoval {
+	if m != nil {
+		return m.RadiusOutlierRemoval
+	}
+	return nil
+}
+
+func (m *Preprocessing) GetDepthFilters() []*DepthFilter {
+	if m != nil {
+		return m.DepthFilters
+	}
+	return nil
+}
+
+// Removes points whose mean distance to their nearest neighbors is unusually
+// large for the frame, like the flying pixels at the edges of objects.
+type StatisticalOutlierRemoval struct {
+	// Number of nearest neighbors to average the distance to. Must be
+	// positive.
+	Neighbors int32 `protobuf:"varint,1,opt,name=neighbors" json:"neighbors,omitempty"`
+	// Points are removed if their mean distance is more than this many
+	// standard deviations above the mean over the frame.
+	StdDevMultiplier float64 `protobuf:"fixed64,2,opt,name=std_dev_multiplier,json=stdDevMultiplier" json:"std_dev_multiplier,omitempty"`
+}
+
+func (m *StatisticalOutlierRemoval) Reset()                    { *m = StatisticalOutlierRemoval{} }
+func (m *StatisticalOutlierRemoval) String() string            { return proto.CompactTextString(m) }
+func (*StatisticalOutlierRemoval) ProtoMessage()               {}
+func (*StatisticalOutlierRemoval) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *StatisticalOutlierRemoval) GetNeighbors() int32 {
+	if m != nil {
+		return m.Neighbors
+	}
+	return 0
+}
+
+func (m *StatisticalOutlierRemoval) GetStdDevMultiplier() float64 {
+	if m != nil {
+		return m.StdDevMultiplier
+	}
+	return 0
+}
+
+// Removes points with few other points near them, like speckle noise.
+type RadiusOutlierRemoval struct {
+	// In meters. Must be positive.
+	Radius float64 `protobuf:"fixed64,1,opt,name=radius" json:"radius,omitempty"`
+	// Points with fewer than this many other points within radius are
+	// removed.
+	MinNeighbors int32 `protobuf:"varint,2,opt,name=min_neighbors,json=minNeighbors" json:"min_neighbors,omitempty"`
+}
+
+func (m *RadiusOutlierRemoval) Reset()                    { *m = RadiusOutlierRemoval{} }
+func (m *RadiusOutlierRemoval) String() string            { return proto.CompactTextString(m) }
+func (*RadiusOutlierRemoval) ProtoMessage()               {}
+func (*RadiusOutlierRemoval) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *RadiusOutlierRemoval) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *RadiusOutlierRemoval) GetMinNeighbors() int32 {
+	if m != nil {
+		return m.MinNeighbors
+	}
+	return 0
+}
+
+// A filter over a depth image, in meters, applied before the image is turned
+// into points. Pixels without a reading are never used to filter others.
+type DepthFilter struct {
+	// Types that are valid to be assigned to Filter:
+	//	*DepthFilter_Bilateral
+	//	*DepthFilter_Median
+	//	*DepthFilter_FlyingPixels
+	//	*DepthFilter_HoleFilling
+	Filter isDepthFilter_Filter `protobuf_oneof:"filter"`
+}
+
+func (m *DepthFilter) Reset()                    { *m = DepthFilter{} }
+func (m *DepthFilter) String() string            { return proto.CompactTextString(m) }
+func (*DepthFilter) ProtoMessage()               {}
+func (*DepthFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+type isDepthFilter_Filter interface{ isDepthFilter_Filter() }
+
+type DepthFilter_Bilateral struct {
+	Bilateral *BilateralFilter `protobuf:"bytes,1,opt,name=bilateral,oneof"`
+}
+type DepthFilter_Median struct {
+	Median *MedianFilter `protobuf:"bytes,2,opt,name=median,oneof"`
+}
+type DepthFilter_FlyingPixels struct {
+	FlyingPixels *FlyingPixelFilter `protobuf:"bytes,3,opt,name=flying_pixels,json=flyingPixels,oneof"`
+}
+type DepthFilter_HoleFilling struct {
+	HoleFilling *HoleFilling `protobuf:"bytes,4,opt,name=hole_filling,json=holeFilling,oneof"`
+}
+
+func (*DepthFilter_Bilateral) isDepthFilter_Filter()    {}
+func (*DepthFilter_Median) isDepthFilter_Filter()       {}
+func (*DepthFilter_FlyingPixels) isDepthFilter_Filter() {}
+func (*DepthFilter_HoleFilling) isDepthFilter_Filter()  {}
+
+func (m *DepthFilter) GetFilter() isDepthFilter_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *DepthFilter) GetBilateral() *BilateralFilter {
+	if x, ok := m.GetFilter().(*DepthFilter_Bilateral); ok {
+		return x.Bilateral
+	}
+	return nil
+}
+
+func (m *DepthFilter) GetMedian() *MedianFilter {
+	if x, ok := m.GetFilter().(*DepthFilter_Median); ok {
+		return x.Median
+	}
+	return nil
+}
+
+func (m *DepthFilter) GetFlyingPixels() *FlyingPixelFilter {
+	if x, ok := m.GetFilter().(*DepthFilter_FlyingPixels); ok {
+		return x.FlyingPixels
+	}
+	return nil
+}
+
+func (m *DepthFilter) GetHoleFilling() *HoleFilling {
+	if x, ok := m.GetFilter().(*DepthFilter_HoleFilling); ok {
+		return x.HoleFilling
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*DepthFilter) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _DepthFilter_OneofMarshaler, _DepthFilter_OneofUnmarshaler, _DepthFilter_OneofSizer, []interface{}{
+		(*DepthFilter_Bilateral)(nil),
+		(*DepthFilter_Median)(nil),
+		(*DepthFilter_FlyingPixels)(nil),
+		(*DepthFilter_HoleFilling)(nil),
+	}
+}
+
+func _DepthFilter_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*DepthFilter)
+	// filter
+	switch x := m.Filter.(type) {
+	case *DepthFilter_Bilateral:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Bilateral); err != nil {
+			return err
+		}
+	case *DepthFilter_Median:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Median); err != nil {
+			return err
+		}
+	case *DepthFilter_FlyingPixels:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FlyingPixels); err != nil {
+			return err
+		}
+	case *DepthFilter_HoleFilling:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.HoleFilling); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("DepthFilter.Filter has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _DepthFilter_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*DepthFilter)
+	switch tag {
+	case 1: // filter.bilateral
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BilateralFilter)
+		err := b.DecodeMessage(msg)
+		m.Filter = &DepthFilter_Bilateral{msg}
+		return true, err
+	case 2: // filter.median
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MedianFilter)
+		err := b.DecodeMessage(msg)
+		m.Filter = &DepthFilter_Median{msg}
+		return true, err
+	case 3: // filter.flying_pixels
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(FlyingPixelFilter)
+		err := b.DecodeMessage(msg)
+		m.Filter = &DepthFilter_FlyingPixels{msg}
+		return true, err
+	case 4: // filter.hole_filling
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(HoleFilling)
+		err := b.DecodeMessage(msg)
+		m.Filter = &DepthFilter_HoleFilling{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _DepthFilter_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*DepthFilter)
+	// filter
+	switch x := m.Filter.(type) {
+	case *DepthFilter_Bilateral:
+		s := proto.Size(x.Bilateral)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DepthFilter_Median:
+		s := proto.Size(x.Median)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DepthFilter_FlyingPixels:
+		s := proto.Size(x.FlyingPixels)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *DepthFilter_HoleFilling:
+		s := proto.Size(x.HoleFilling)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// Smooths each pixel with its neighbors, weighted both by how near they are in
+// the image and by how near their depths are, so that edges aren't blurred.
+type BilateralFilter struct {
+	// Pixels up to this many rows and columns away are neighbors. Between 1
+	// and 10.
+	Radius int32 `protobuf:"varint,1,opt,name=radius" json:"radius,omitempty"`
+	// Standard deviations of the weights, in pixels and in meters
+	// respectively. Both must be positive.
+	SpatialSigma float64 `protobuf:"fixed64,2,opt,name=spatial_sigma,json=spatialSigma" json:"spatial_sigma,omitempty"`
+	RangeSigma   float64 `protobuf:"fixed64,3,opt,name=range_sigma,json=rangeSigma" json:"range_sigma,omitempty"`
+}
+
+func (m *BilateralFilter) Reset()                    { *m = BilateralFilter{} }
+func (m *BilateralFilter) String() string            { return proto.CompactTextString(m) }
+func (*BilateralFilter) ProtoMessage()               {}
+func (*BilateralFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *BilateralFilter) GetRadius() int32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *BilateralFilter) GetSpatialSigma() float64 {
+	if m != nil {
+		return m.SpatialSigma
+	}
+	return 0
+}
+
+func (m *BilateralFilter) GetRangeSigma() float64 {
+	if m != nil {
+		return m.RangeSigma
+	}
+	return 0
+}
+
+// Replaces each pixel with the median of it and its neighbors, which removes
+// isolated spikes.
+type MedianFilter struct {
+	// Pixels up to this many rows and columns away are neighbors. Between 1
+	// and 10.
+	Radius int32 `protobuf:"varint,1,opt,name=radius" json:"radius,omitempty"`
+}
+
+func (m *MedianFilter) Reset()                    { *m = MedianFilter{} }
+func (m *MedianFilter) String() string            { return proto.CompactTextString(m) }
+func (*MedianFilter) ProtoMessage()               {}
+func (*MedianFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *MedianFilter) GetRadius() int32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+// Removes pixels at depth discontinuities, where the sensor often reports
+// depths between the foreground and the background.
+type FlyingPixelFilter struct {
+	// A pixel is removed if any of the 8 pixels around it differs in depth by
+	// more than this fraction of the pixel's depth. Must be positive.
+	MaxRelativeJump float64 `protobuf:"fixed64,1,opt,name=max_relative_jump,json=maxRelativeJump" json:"max_relative_jump,omitempty"`
+}
+
+func (m *FlyingPixelFilter) Reset()                    { *m = FlyingPixelFilter{} }
+func (m *FlyingPixelFilter) String() string            { return proto.CompactTextString(m) }
+func (*FlyingPixelFilter) ProtoMessage()               {}
+func (*FlyingPixelFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *FlyingPixelFilter) GetMaxRelativeJump() float64 {
+	if m != nil {
+		return m.MaxRelativeJump
+	}
+	return 0
+}
+
+// Fills pixels without a reading using the median of the readings around
+// them, if enough are nearby for the hole to be small.
+type HoleFilling struct {
+	// Pixels up to this many rows and columns away are neighbors. Between 1
+	// and 10.
+	Radius int32 `protobuf:"varint,1,opt,name=radius" json:"radius,omitempty"`
+	// A missing pixel is only filled if at least this many of its neighbors
+	// have readings. Must be positive.
+	MinNeighbors int32 `protobuf:"varint,2,opt,name=min_neighbors,json=minNeighbors" json:"min_neighbors,omitempty"`
+}
+
+func (m *HoleFilling) Reset()                    { *m = HoleFilling{} }
+func (m *HoleFilling) String() string            { return proto.CompactTextString(m) }
+func (*HoleFilling) ProtoMessage()               {}
+func (*HoleFilling) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *HoleFilling) GetRadius() int32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *HoleFilling) GetMinNeighbors() int32 {
+	if m != nil {
+		return m.MinNeighbors
+	}
+	return 0
+}
+
+// A frame as it was added to a project.
+type Frame struct {
+	// Zero for frames added with Add.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
+	// Capture time in nanoseconds since the Unix epoch. For frames added with
+	// Add, this is when the server received the frame.
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	Depth     *Depth `protobuf:"bytes,3,opt,name=depth" json:"depth,omitempty"`
+	// Transforms the frame's camera space into the project's world space.
+	Pose *Pose `protobuf:"bytes,4,opt,name=pose" json:"pose,omitempty"`
+}
+
+func (m *Frame) Reset()                    { *m = Frame{} }
+func (m *Frame) String() string            { return proto.CompactTextString(m) }
+func (*Frame) ProtoMessage()               {}
+func (*Frame) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *Frame) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Frame) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Frame) GetDepth() *Depth {
+	if m != nil {
+		return m.Depth
+	}
+	return nil
+}
+
+func (m *Frame) GetPose() *Pose {
+	if m != nil {
+		return m.Pose
+	}
+	return nil
+}
+
+// How a frame was aligned with the rest of its project.
+type Registration struct {
+	// Transforms the frame's camera space into the project's world space.
+	Pose *Pose `protobuf:"bytes,1,opt,name=pose" json:"pose,omitempty"`
+	// False if the frame couldn't be aligned, in which case pose is that of
+	// the frame it was compared against. Always false for a project's first
+	// frame, which defines world space.
+	Registered bool `protobuf:"varint,2,opt,name=registered" json:"registered,omitempty"`
+	// Root mean square distance in meters between corresponding points after
+	// alignment, and how many correspondences there were.
+	Rmse       float64 `protobuf:"fixed64,3,opt,name=rmse" json:"rmse,omitempty"`
+	Inliers    int32   `protobuf:"varint,4,opt,name=inliers" json:"inliers,omitempty"`
+	Iterations int32   `protobuf:"varint,5,opt,name=iterations" json:"iterations,omitempty"`
+}
+
+func (m *Registration) Reset()                    { *m = Registration{} }
+func (m *Registration) String() string            { return proto.CompactTextString(m) }
+func (*Registration) ProtoMessage()               {}
+func (*Registration) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *Registration) GetPose() *Pose {
+	if m != nil {
+		return m.Pose
+	}
+	return nil
+}
+
+func (m *Registration) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
+	}
+	return false
+}
+
+func (m *Registration) GetRmse() float64 {
+	if m != nil {
+		return m.Rmse
+	}
+	return 0
+}
+
+func (m *Registration) GetInliers() int32 {
+	if m != nil {
+		return m.Inliers
+	}
+	return 0
+}
 
-func (m *RetrieveRequest) GetName() string {
+func (m *Registration) GetIterations() int32 {
 	if m != nil {
-		return m.Name
+		return m.Iterations
 	}
-	return ""
+	return 0
 }
 
-type RetrieveResponse struct {
-	Points []*Point `protobuf:"bytes,1,rep,name=points" json:"points,omitempty"`
+// A rigid transform.
+type Pose struct {
+	// 4x4 matrix in row major order.
+	Matrix []float64 `protobuf:"fixed64,1,rep,packed,name=matrix" json:"matrix,omitempty"`
 }
 
-func (m *RetrieveResponse) Reset()                    { *m = RetrieveResponse{} }
-func (m *RetrieveResponse) String() string            { return proto.CompactTextString(m) }
-func (*RetrieveResponse) ProtoMessage()               {}
-func (*RetrieveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (m *Pose) Reset()                    { *m = Pose{} }
+func (m *Pose) String() string            { return proto.CompactTextString(m) }
+func (*Pose) ProtoMessage()               {}
+func (*Pose) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
-func (m *RetrieveResponse) GetPoints() []*Point {
+func (m *Pose) GetMatrix() []float64 {
 	if m != nil {
-		return m.Points
+		return m.Matrix
 	}
 	return nil
 }
@@ -138,7 +1191,7 @@ type Point struct {
 func (m *Point) Reset()                    { *m = Point{} }
 func (m *Point) String() string            { return proto.CompactTextString(m) }
 func (*Point) ProtoMessage()               {}
-func (*Point) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*Point) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *Point) GetX() float32 {
 	if m != nil {
@@ -164,14 +1217,20 @@ func (m *Point) GetZ() float32 {
 type Depth struct {
 	Rows []*Row `protobuf:"bytes,1,rep,name=rows" json:"rows,omitempty"`
 	// FOV in degrees
-	XFov float32 `protobuf:"fixed32,2,opt,name=x_fov,json=xFov" json:"x_fov,omitempty"`
-	YFov float32 `protobuf:"fixed32,3,opt,name=y_fov,json=yFov" json:"y_fov,omitempty"`
+	XFov     float32        `protobuf:"fixed32,2,opt,name=x_fov,json=xFov" json:"x_fov,omitempty"`
+	YFov     float32        `protobuf:"fixed32,3,opt,name=y_fov,json=yFov" json:"y_fov,omitempty"`
+	Encoding Depth_Encoding `protobuf:"varint,4,opt,name=encoding,enum=Depth_Encoding" json:"encoding,omitempty"`
+	// Range in meters covered by NORMALIZED_8BIT values.
+	MinDepth float32 `protobuf:"fixed32,5,opt,name=min_depth,json=minDepth" json:"min_depth,omitempty"`
+	MaxDepth float32 `protobuf:"fixed32,6,opt,name=max_depth,json=maxDepth" json:"max_depth,omitempty"`
+	// A more compact alternative to rows. If set, rows are ignored.
+	Packed *PackedDepth `protobuf:"bytes,7,opt,name=packed" json:"packed,omitempty"`
 }
 
 func (m *Depth) Reset()                    { *m = Depth{} }
 func (m *Depth) String() string            { return proto.CompactTextString(m) }
 func (*Depth) ProtoMessage()               {}
-func (*Depth) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*Depth) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *Depth) GetRows() []*Row {
 	if m != nil {
@@ -194,14 +1253,95 @@ func (m *Depth) GetYFov() float32 {
 	return 0
 }
 
+func (m *Depth) GetEncoding() Depth_Encoding {
+	if m != nil {
+		return m.Encoding
+	}
+	return Depth_ENCODING_UNSPECIFIED
+}
+
+func (m *Depth) GetMinDepth() float32 {
+	if m != nil {
+		return m.MinDepth
+	}
+	return 0
+}
+
+func (m *Depth) GetMaxDepth() float32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *Depth) GetPacked() *PackedDepth {
+	if m != nil {
+		return m.Packed
+	}
+	return nil
+}
+
+// A whole depth frame in a single buffer. Values are interpreted according to
+// the enclosing Depth's encoding, which can't be METERS.
+type PackedDepth struct {
+	Width  int32 `protobuf:"varint,1,opt,name=width" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
+	// Bits per value. Either 8 or 16.
+	BitDepth    int32                   `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth" json:"bit_depth,omitempty"`
+	Compression PackedDepth_Compression `protobuf:"varint,4,opt,name=compression,enum=PackedDepth_Compression" json:"compression,omitempty"`
+	Data        []byte                  `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PackedDepth) Reset()                    { *m = PackedDepth{} }
+func (m *PackedDepth) String() string            { return proto.CompactTextString(m) }
+func (*PackedDepth) ProtoMessage()               {}
+func (*PackedDepth) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *PackedDepth) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *PackedDepth) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PackedDepth) GetBitDepth() int32 {
+	if m != nil {
+		return m.BitDepth
+	}
+	return 0
+}
+
+func (m *PackedDepth) GetCompression() PackedDepth_Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return PackedDepth_NONE
+}
+
+func (m *PackedDepth) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type Row struct {
-	Values []int32 `protobuf:"varint,1,rep,packed,name=values" json:"values,omitempty"`
+	// Depth along the sensor's optical axis. See Depth.Encoding for units.
+	Values      []int32   `protobuf:"varint,1,rep,packed,name=values" json:"values,omitempty"`
+	FloatValues []float32 `protobuf:"fixed32,2,rep,packed,name=float_values,json=floatValues" json:"float_values,omitempty"`
 }
 
 func (m *Row) Reset()                    { *m = Row{} }
 func (m *Row) String() string            { return proto.CompactTextString(m) }
 func (*Row) ProtoMessage()               {}
-func (*Row) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*Row) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *Row) GetValues() []int32 {
 	if m != nil {
@@ -210,16 +1350,54 @@ func (m *Row) GetValues() []int32 {
 	return nil
 }
 
+func (m *Row) GetFloatValues() []float32 {
+	if m != nil {
+		return m.FloatValues
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreateProjectRequest)(nil), "CreateProjectRequest")
 	proto.RegisterType((*CreateProjectResponse)(nil), "CreateProjectResponse")
 	proto.RegisterType((*AddRequest)(nil), "AddRequest")
 	proto.RegisterType((*AddResponse)(nil), "AddResponse")
+	proto.RegisterType((*AddStreamRequest)(nil), "AddStreamRequest")
+	proto.RegisterType((*AddStreamResponse)(nil), "AddStreamResponse")
 	proto.RegisterType((*RetrieveRequest)(nil), "RetrieveRequest")
 	proto.RegisterType((*RetrieveResponse)(nil), "RetrieveResponse")
+	proto.RegisterType((*RetrieveStreamRequest)(nil), "RetrieveStreamRequest")
+	proto.RegisterType((*RetrieveStreamResponse)(nil), "RetrieveStreamResponse")
+	proto.RegisterType((*ProjectInfo)(nil), "ProjectInfo")
+	proto.RegisterType((*ListProjectsRequest)(nil), "ListProjectsRequest")
+	proto.RegisterType((*ListProjectsResponse)(nil), "ListProjectsResponse")
+	proto.RegisterType((*GetProjectRequest)(nil), "GetProjectRequest")
+	proto.RegisterType((*GetProjectResponse)(nil), "GetProjectResponse")
+	proto.RegisterType((*DeleteProjectRequest)(nil), "DeleteProjectRequest")
+	proto.RegisterType((*DeleteProjectResponse)(nil), "DeleteProjectResponse")
+	proto.RegisterType((*RenameProjectRequest)(nil), "RenameProjectRequest")
+	proto.RegisterType((*RenameProjectResponse)(nil), "RenameProjectResponse")
+	proto.RegisterType((*ClearProjectRequest)(nil), "ClearProjectRequest")
+	proto.RegisterType((*ClearProjectResponse)(nil), "ClearProjectResponse")
+	proto.RegisterType((*SetPreprocessingRequest)(nil), "SetPreprocessingRequest")
+	proto.RegisterType((*SetPreprocessingResponse)(nil), "SetPreprocessingResponse")
+	proto.RegisterType((*Preprocessing)(nil), "Preprocessing")
+	proto.RegisterType((*StatisticalOutlierRemoval)(nil), "StatisticalOutlierRemoval")
+	proto.RegisterType((*RadiusOutlierRemoval)(nil), "RadiusOutlierRemoval")
+	proto.RegisterType((*DepthFilter)(nil), "DepthFilter")
+	proto.RegisterType((*BilateralFilter)(nil), "BilateralFilter")
+	proto.RegisterType((*MedianFilter)(nil), "MedianFilter")
+	proto.RegisterType((*FlyingPixelFilter)(nil), "FlyingPixelFilter")
+	proto.RegisterType((*HoleFilling)(nil), "HoleFilling")
+	proto.RegisterType((*Frame)(nil), "Frame")
+	proto.RegisterType((*Registration)(nil), "Registration")
+	proto.RegisterType((*Pose)(nil), "Pose")
 	proto.RegisterType((*Point)(nil), "Point")
 	proto.RegisterType((*Depth)(nil), "Depth")
+	proto.RegisterType((*PackedDepth)(nil), "PackedDepth")
 	proto.RegisterType((*Row)(nil), "Row")
+	proto.RegisterEnum("Depth_Encoding", Depth_Encoding_name, Depth_Encoding_value)
+	proto.RegisterEnum("PackedDepth_Compression", PackedDepth_Compression_name, PackedDepth_Compression_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -234,8 +1412,33 @@ const _ = grpc.SupportPackageIsVersion4
 
 type MeshBuilderClient interface {
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	// Fails with INVALID_ARGUMENT, with a BadRequest detail naming the field at
+	// fault, if the frame can't be processed, and with RESOURCE_EXHAUSTED if
+	// the project is too large to add the frame to.
 	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
 	Retrieve(ctx context.Context, in *RetrieveRequest, opts ...grpc.CallOption) (*RetrieveResponse, error)
+	// Adds a stream of frames to a single project, which is named by the first
+	// request in the stream. Frames are added in the order they're received.
+	// Any frame whose sequence number isn't greater than that of the last
+	// frame added is dropped, as are frames that can't be processed. The
+	// stream fails if the project becomes too large to add a frame to.
+	AddStream(ctx context.Context, opts ...grpc.CallOption) (MeshBuilder_AddStreamClient, error)
+	// Streams a project's points in chunks, so that large projects don't
+	// exceed gRPC's message size limit. With watch set, the stream stays open
+	// after the existing points are sent, and points from frames added later
+	// are streamed as they arrive until the client cancels.
+	RetrieveStream(ctx context.Context, in *RetrieveStreamRequest, opts ...grpc.CallOption) (MeshBuilder_RetrieveStreamClient, error)
+	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
+	// Deletes a project and everything stored for it.
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	// Renames a project. Fails if a project with the new name already exists.
+	RenameProject(ctx context.Context, in *RenameProjectRequest, opts ...grpc.CallOption) (*RenameProjectResponse, error)
+	// Removes every frame and point from a project, but keeps the project.
+	ClearProject(ctx context.Context, in *ClearProjectRequest, opts ...grpc.CallOption) (*ClearProjectResponse, error)
+	// Changes how frames added to a project from now on are preprocessed.
+	// Frames already in the project are left as they are.
+	SetPreprocessing(ctx context.Context, in *SetPreprocessingRequest, opts ...grpc.CallOption) (*SetPreprocessingResponse, error)
 }
 
 type meshBuilderClient struct {
@@ -273,12 +1476,157 @@ func (c *meshBuilderClient) Retrieve(ctx context.Context, in *RetrieveRequest, o
 	return out, nil
 }
 
+func (c *meshBuilderClient) AddStream(ctx context.Context, opts ...grpc.CallOption) (MeshBuilder_AddStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_MeshBuilder_serviceDesc.Streams[0], c.cc, "/MeshBuilder/AddStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &meshBuilderAddStreamClient{stream}
+	return x, nil
+}
+
+type MeshBuilder_AddStreamClient interface {
+	Send(*AddStreamRequest) error
+	CloseAndRecv() (*AddStreamResponse, error)
+	grpc.ClientStream
+}
+
+type meshBuilderAddStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *meshBuilderAddStreamClient) Send(m *AddStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *meshBuilderAddStreamClient) CloseAndRecv() (*AddStreamResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AddStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *meshBuilderClient) RetrieveStream(ctx context.Context, in *RetrieveStreamRequest, opts ...grpc.CallOption) (MeshBuilder_RetrieveStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_MeshBuilder_serviceDesc.Streams[1], c.cc, "/MeshBuilder/RetrieveStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &meshBuilderRetrieveStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MeshBuilder_RetrieveStreamClient interface {
+	Recv() (*RetrieveStreamResponse, error)
+	grpc.ClientStream
+}
+
+type meshBuilderRetrieveStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *meshBuilderRetrieveStreamClient) Recv() (*RetrieveStreamResponse, error) {
+	m := new(RetrieveStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *meshBuilderClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := grpc.Invoke(ctx, "/MeshBuilder/ListProjects", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshBuilderClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
+	out := new(GetProjectResponse)
+	err := grpc.Invoke(ctx, "/MeshBuilder/GetProject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshBuilderClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := grpc.Invoke(ctx, "/MeshBuilder/DeleteProject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshBuilderClient) RenameProject(ctx context.Context, in *RenameProjectRequest, opts ...grpc.CallOption) (*RenameProjectResponse, error) {
+	out := new(RenameProjectResponse)
+	err := grpc.Invoke(ctx, "/MeshBuilder/RenameProject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshBuilderClient) ClearProject(ctx context.Context, in *ClearProjectRequest, opts ...grpc.CallOption) (*ClearProjectResponse, error) {
+	out := new(ClearProjectResponse)
+	err := grpc.Invoke(ctx, "/MeshBuilder/ClearProject", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshBuilderClient) SetPreprocessing(ctx context.Context, in *SetPreprocessingRequest, opts ...grpc.CallOption) (*SetPreprocessingResponse, error) {
+	out := new(SetPreprocessingResponse)
+	err := grpc.Invoke(ctx, "/MeshBuilder/SetPreprocessing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for MeshBuilder service
 
 type MeshBuilderServer interface {
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// Fails with INVALID_ARGUMENT, with a BadRequest detail naming the field at
+	// fault, if the frame can't be processed, and with RESOURCE_EXHAUSTED if
+	// the project is too large to add the frame to.
 	Add(context.Context, *AddRequest) (*AddResponse, error)
 	Retrieve(context.Context, *RetrieveRequest) (*RetrieveResponse, error)
+	// Adds a stream of frames to a single project, which is named by the first
+	// request in the stream. Frames are added in the order they're received.
+	// Any frame whose sequence number isn't greater than that of the last
+	// frame added is dropped, as are frames that can't be processed. The
+	// stream fails if the project becomes too large to add a frame to.
+	AddStream(MeshBuilder_AddStreamServer) error
+	// Streams a project's points in chunks, so that large projects don't
+	// exceed gRPC's message size limit. With watch set, the stream stays open
+	// after the existing points are sent, and points from frames added later
+	// are streamed as they arrive until the client cancels.
+	RetrieveStream(*RetrieveStreamRequest, MeshBuilder_RetrieveStreamServer) error
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
+	// Deletes a project and everything stored for it.
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	// Renames a project. Fails if a project with the new name already exists.
+	RenameProject(context.Context, *RenameProjectRequest) (*RenameProjectResponse, error)
+	// Removes every frame and point from a project, but keeps the project.
+	ClearProject(context.Context, *ClearProjectRequest) (*ClearProjectResponse, error)
+	// Changes how frames added to a project from now on are preprocessed.
+	// Frames already in the project are left as they are.
+	SetPreprocessing(context.Context, *SetPreprocessingRequest) (*SetPreprocessingResponse, error)
 }
 
 func RegisterMeshBuilderServer(s *grpc.Server, srv MeshBuilderServer) {
@@ -339,6 +1687,161 @@ func _MeshBuilder_Retrieve_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MeshBuilder_AddStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MeshBuilderServer).AddStream(&meshBuilderAddStreamServer{stream})
+}
+
+type MeshBuilder_AddStreamServer interface {
+	SendAndClose(*AddStreamResponse) error
+	Recv() (*AddStreamRequest, error)
+	grpc.ServerStream
+}
+
+type meshBuilderAddStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *meshBuilderAddStreamServer) SendAndClose(m *AddStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *meshBuilderAddStreamServer) Recv() (*AddStreamRequest, error) {
+	m := new(AddStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MeshBuilder_RetrieveStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RetrieveStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MeshBuilderServer).RetrieveStream(m, &meshBuilderRetrieveStreamServer{stream})
+}
+
+type MeshBuilder_RetrieveStreamServer interface {
+	Send(*RetrieveStreamResponse) error
+	grpc.ServerStream
+}
+
+type meshBuilderRetrieveStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *meshBuilderRetrieveStreamServer) Send(m *RetrieveStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MeshBuilder_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshBuilderServer).ListProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MeshBuilder/ListProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshBuilderServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeshBuilder_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshBuilderServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MeshBuilder/GetProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshBuilderServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeshBuilder_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshBuilderServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MeshBuilder/DeleteProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshBuilderServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeshBuilder_RenameProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshBuilderServer).RenameProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MeshBuilder/RenameProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshBuilderServer).RenameProject(ctx, req.(*RenameProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeshBuilder_ClearProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshBuilderServer).ClearProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MeshBuilder/ClearProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshBuilderServer).ClearProject(ctx, req.(*ClearProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeshBuilder_SetPreprocessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPreprocessingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshBuilderServer).SetPreprocessing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/MeshBuilder/SetPreprocessing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshBuilderServer).SetPreprocessing(ctx, req.(*SetPreprocessingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MeshBuilder_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "MeshBuilder",
 	HandlerType: (*MeshBuilderServer)(nil),
@@ -355,35 +1858,158 @@ var _MeshBuilder_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Retrieve",
 			Handler:    _MeshBuilder_Retrieve_Handler,
 		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _MeshBuilder_ListProjects_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _MeshBuilder_GetProject_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _MeshBuilder_DeleteProject_Handler,
+		},
+		{
+			MethodName: "RenameProject",
+			Handler:    _MeshBuilder_RenameProject_Handler,
+		},
+		{
+			MethodName: "ClearProject",
+			Handler:    _MeshBuilder_ClearProject_Handler,
+		},
+		{
+			MethodName: "SetPreprocessing",
+			Handler:    _MeshBuilder_SetPreprocessing_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddStream",
+			Handler:       _MeshBuilder_AddStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RetrieveStream",
+			Handler:       _MeshBuilder_RetrieveStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "meshbuilder.proto",
 }
 
 func init() { proto.RegisterFile("meshbuilder.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x5f, 0x4b, 0x02, 0x41,
-	0x14, 0xc5, 0x1d, 0xf7, 0x0f, 0x75, 0x57, 0x49, 0x6f, 0x69, 0x8b, 0x54, 0xc8, 0x40, 0x20, 0x3d,
-	0x0c, 0x68, 0xef, 0x91, 0x15, 0xbe, 0x44, 0x20, 0xf3, 0x54, 0xbd, 0x84, 0xb6, 0x37, 0x34, 0xd4,
-	0xd9, 0x76, 0xc7, 0x55, 0xfb, 0x40, 0x7d, 0xce, 0xd8, 0xd9, 0x15, 0x4b, 0xc4, 0xb7, 0x3d, 0x87,
-	0x7b, 0x7f, 0x7b, 0x39, 0x67, 0xa0, 0x3a, 0xa5, 0x78, 0x34, 0x9c, 0x8f, 0x27, 0x01, 0x45, 0x22,
-	0x8c, 0x94, 0x56, 0xfc, 0x0a, 0x4e, 0xee, 0x23, 0x1a, 0x68, 0xea, 0x47, 0xea, 0x93, 0xde, 0xb5,
-	0xa4, 0xaf, 0x39, 0xc5, 0x1a, 0x11, 0xec, 0xd9, 0x60, 0x4a, 0x3e, 0x6b, 0xb2, 0xd6, 0xa1, 0x34,
-	0xdf, 0xfc, 0x14, 0x6a, 0x5b, 0xb3, 0x71, 0xa8, 0x66, 0x31, 0xf1, 0x1b, 0x80, 0x6e, 0x10, 0xec,
-	0x59, 0xc5, 0x33, 0x70, 0x02, 0x0a, 0xf5, 0xc8, 0x2f, 0x36, 0x59, 0xcb, 0xeb, 0xb8, 0xe2, 0x21,
-	0x55, 0x32, 0x33, 0x79, 0x19, 0x3c, 0xb3, 0x9f, 0xe3, 0x2e, 0xe1, 0x48, 0x92, 0x8e, 0xc6, 0x94,
-	0xd0, 0xbe, 0x73, 0x3a, 0x50, 0xd9, 0x8c, 0x65, 0xab, 0x78, 0x01, 0x6e, 0xa8, 0xc6, 0x33, 0x1d,
-	0xfb, 0xac, 0x69, 0x99, 0x1f, 0xf5, 0x53, 0x29, 0x73, 0x97, 0xb7, 0xc1, 0x31, 0x06, 0x96, 0x80,
-	0x3d, 0x1b, 0x5a, 0x51, 0xb2, 0x65, 0xaa, 0x5e, 0xcc, 0x69, 0x45, 0xc9, 0x56, 0xa9, 0x7a, 0xf5,
-	0xad, 0x4c, 0x7d, 0xf3, 0x47, 0x70, 0xcc, 0xb1, 0xe8, 0x83, 0x1d, 0xa9, 0xc5, 0x9a, 0x6c, 0x0b,
-	0xa9, 0x16, 0xd2, 0x38, 0x78, 0x0c, 0xce, 0xf2, 0xed, 0x43, 0x25, 0x39, 0xc2, 0x5e, 0xf6, 0x54,
-	0x92, 0x9a, 0x2b, 0x63, 0x66, 0x24, 0x7b, 0xd5, 0x53, 0x09, 0x3f, 0x07, 0x4b, 0xaa, 0x05, 0xd6,
-	0xc1, 0x4d, 0x06, 0x93, 0x39, 0x65, 0x30, 0x47, 0xe6, 0xaa, 0xf3, 0xc3, 0xc0, 0x7b, 0xa2, 0x78,
-	0x74, 0x97, 0x75, 0x84, 0xb7, 0x50, 0xfe, 0x97, 0x38, 0xd6, 0xc4, 0xae, 0xb6, 0x1a, 0x75, 0xb1,
-	0xbb, 0x98, 0x02, 0x72, 0xb0, 0xba, 0x41, 0x80, 0x9e, 0xd8, 0x14, 0xd4, 0x28, 0x89, 0xbf, 0x69,
-	0x17, 0xb0, 0x0d, 0x07, 0xeb, 0x20, 0xb1, 0x22, 0xb6, 0xa2, 0x6f, 0x54, 0xc5, 0x76, 0xca, 0xbc,
-	0x30, 0x74, 0xcd, 0xeb, 0xb9, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x10, 0x4c, 0x4d, 0x52,
-	0x02, 0x00, 0x00,
+	// 1759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xeb, 0x72, 0x1a, 0xc9,
+	0x15, 0x66, 0xb8, 0x19, 0x1d, 0x40, 0x42, 0x2d, 0x90, 0x11, 0xbb, 0xf1, 0x2a, 0x9d, 0x78, 0xad,
+	0x38, 0xa9, 0xae, 0xb5, 0x92, 0xca, 0xad, 0x72, 0xb1, 0x2e, 0xc8, 0x66, 0x57, 0x42, 0xaa, 0x96,
+	0x37, 0x95, 0xf5, 0x9f, 0xc9, 0x88, 0x69, 0x41, 0x6f, 0xe6, 0x96, 0xe9, 0x46, 0xc2, 0xfb, 0x23,
+	0xbf, 0x52, 0x79, 0x85, 0x3c, 0xc4, 0xbe, 0x47, 0x9e, 0x20, 0x0f, 0x90, 0xff, 0x79, 0x89, 0x54,
+	0x5f, 0x80, 0x01, 0x21, 0x59, 0xff, 0x38, 0xdf, 0x39, 0x73, 0xfa, 0xdc, 0xa6, 0xe7, 0x3b, 0xc0,
+	0x66, 0xc8, 0xc4, 0xe8, 0x6a, 0xcc, 0x03, 0x9f, 0xa5, 0x24, 0x49, 0x63, 0x19, 0xe3, 0x7f, 0x38,
+	0xd0, 0x3c, 0x4a, 0x99, 0x27, 0xd9, 0x45, 0x1a, 0x7f, 0xcb, 0x06, 0x92, 0xb2, 0xbf, 0x8d, 0x99,
+	0x90, 0x08, 0x41, 0x31, 0xf2, 0x42, 0xd6, 0x76, 0x76, 0x9d, 0xbd, 0x35, 0xaa, 0x7f, 0x23, 0x0c,
+	0xf5, 0x21, 0x93, 0x6e, 0x9c, 0xba, 0x03, 0xfd, 0x48, 0x3b, 0xbf, 0xeb, 0xec, 0x55, 0x68, 0x75,
+	0xc8, 0xe4, 0x79, 0x6a, 0xbc, 0xa0, 0x5f, 0x40, 0x3d, 0x49, 0x59, 0x92, 0xc6, 0x03, 0x26, 0x04,
+	0x8f, 0x86, 0xed, 0xc2, 0xae, 0xb3, 0x57, 0xdd, 0x5f, 0x27, 0x17, 0x59, 0x94, 0x2e, 0x1a, 0x61,
+	0x0e, 0xad, 0xa5, 0x28, 0x44, 0x12, 0x47, 0x82, 0xa1, 0x75, 0xc8, 0x73, 0xdf, 0x06, 0x91, 0xe7,
+	0x3e, 0xfa, 0x1c, 0x9e, 0x24, 0xc6, 0x44, 0x1f, 0x5e, 0xdd, 0xaf, 0x11, 0xfb, 0x48, 0x2f, 0xba,
+	0x8e, 0xe9, 0x54, 0x89, 0xda, 0xf0, 0xc4, 0xc4, 0xe8, 0xeb, 0x00, 0x2a, 0x74, 0x2a, 0xe2, 0x3f,
+	0x00, 0x1c, 0xf8, 0xfe, 0x43, 0x69, 0x7e, 0x0a, 0x25, 0x9f, 0x25, 0x72, 0x64, 0x4f, 0x28, 0x93,
+	0x63, 0x25, 0x51, 0x03, 0xe2, 0xd7, 0x50, 0xd5, 0xcf, 0xdb, 0x00, 0x5f, 0x41, 0x2d, 0x65, 0x43,
+	0x2e, 0x64, 0xea, 0x49, 0x1e, 0x47, 0xda, 0x51, 0x75, 0xbf, 0x4e, 0x68, 0x06, 0xa4, 0x0b, 0x26,
+	0xf8, 0xef, 0xd0, 0x38, 0xf0, 0xfd, 0x4b, 0x99, 0x32, 0x2f, 0x7c, 0x28, 0x8e, 0x0e, 0x54, 0x84,
+	0x52, 0x47, 0x03, 0x53, 0xe9, 0x22, 0x9d, 0xc9, 0xe8, 0x53, 0x58, 0x93, 0x3c, 0x64, 0x42, 0x7a,
+	0x61, 0xa2, 0x33, 0x2c, 0xd0, 0x39, 0x30, 0xcf, 0xa0, 0xb8, 0x2a, 0x83, 0x7f, 0x3a, 0xb0, 0x99,
+	0x09, 0xc0, 0x26, 0xf2, 0x02, 0x36, 0xae, 0x53, 0x2f, 0x64, 0xc2, 0xf5, 0x06, 0x03, 0x96, 0xa8,
+	0xca, 0x39, 0xda, 0xef, 0xba, 0x81, 0x0f, 0x2c, 0x8a, 0x9e, 0x83, 0x45, 0x5c, 0x3f, 0x8d, 0x93,
+	0x84, 0xf9, 0x3a, 0xb8, 0x02, 0xad, 0x1b, 0xf4, 0xd8, 0x80, 0xe8, 0x87, 0x50, 0x4b, 0x62, 0x1e,
+	0x49, 0xe1, 0x7a, 0xbe, 0x6f, 0xdb, 0x50, 0xa0, 0x55, 0x83, 0x1d, 0x28, 0x08, 0x3f, 0x87, 0x0d,
+	0xca, 0x64, 0xca, 0xd9, 0x0d, 0x7b, 0xa0, 0x0e, 0x78, 0x1f, 0x1a, 0x73, 0x33, 0x1b, 0xed, 0x33,
+	0x28, 0x1b, 0x4f, 0x6d, 0x67, 0xb7, 0xa0, 0x53, 0xbc, 0x50, 0x22, 0xb5, 0x28, 0xfe, 0x0b, 0xb4,
+	0xa6, 0xcf, 0x7c, 0xbc, 0xd0, 0x3f, 0x00, 0x08, 0xbd, 0x89, 0x6b, 0x1d, 0xaa, 0x6c, 0x4a, 0x74,
+	0x2d, 0xf4, 0x26, 0xda, 0xa5, 0x40, 0x4d, 0x28, 0xdd, 0x7a, 0x72, 0x30, 0xb2, 0x93, 0x64, 0x04,
+	0x7c, 0x03, 0xdb, 0xcb, 0x27, 0x3c, 0x2e, 0x36, 0xf4, 0x19, 0xd8, 0x2a, 0xb8, 0x82, 0x45, 0xd2,
+	0x56, 0x0f, 0x0c, 0x74, 0xc9, 0x22, 0x89, 0x3e, 0x81, 0xb5, 0x81, 0x37, 0x1e, 0x8e, 0xa4, 0x3b,
+	0x4e, 0xec, 0xa1, 0x15, 0x03, 0x7c, 0x9d, 0xe0, 0xef, 0xf3, 0x50, 0xcd, 0x8c, 0xfc, 0xca, 0x84,
+	0x3e, 0x83, 0xaa, 0x6e, 0x86, 0x3b, 0x88, 0xc7, 0xf3, 0x13, 0x34, 0x74, 0xa4, 0x90, 0x59, 0x08,
+	0xd6, 0xa0, 0x90, 0x09, 0xc1, 0x18, 0x3c, 0x07, 0xb8, 0x8a, 0xc7, 0x91, 0x2f, 0xdc, 0x90, 0x47,
+	0xb3, 0x31, 0x32, 0x79, 0xac, 0x19, 0xcd, 0x19, 0x8f, 0xb2, 0x66, 0xde, 0xa4, 0x5d, 0x5a, 0x69,
+	0xe6, 0x4d, 0xb2, 0x6f, 0x63, 0x59, 0x1f, 0x35, 0x15, 0x95, 0x66, 0x9c, 0xf8, 0x5a, 0xf3, 0xc4,
+	0x68, 0xac, 0x68, 0xdf, 0xfc, 0xca, 0xec, 0xcd, 0xbf, 0x73, 0xb1, 0xac, 0x3d, 0xe6, 0x62, 0x69,
+	0xc1, 0xd6, 0x29, 0x17, 0xd2, 0x16, 0x4c, 0xd8, 0x29, 0xc0, 0xaf, 0xa1, 0xb9, 0x08, 0xdb, 0xd6,
+	0xed, 0x41, 0xc5, 0xde, 0x20, 0xd3, 0xe6, 0x2d, 0xde, 0x2f, 0x33, 0x2d, 0x7e, 0x01, 0x9b, 0x6f,
+	0x98, 0xfc, 0xf8, 0xa5, 0x89, 0x7f, 0x07, 0x28, 0x6b, 0x68, 0x0f, 0xca, 0xdc, 0x63, 0xce, 0x03,
+	0xf7, 0x18, 0x7e, 0x09, 0xcd, 0x63, 0x16, 0xb0, 0xc7, 0x5c, 0xcf, 0xf8, 0x29, 0xb4, 0x96, 0x6c,
+	0xcd, 0x61, 0xb8, 0x0b, 0x4d, 0xca, 0x94, 0xc9, 0xc7, 0x9d, 0xa0, 0x1d, 0xa8, 0x44, 0xec, 0xd6,
+	0xd5, 0x78, 0x5e, 0xe3, 0x4f, 0x22, 0x76, 0xdb, 0xb7, 0xfe, 0x97, 0xdc, 0x58, 0xff, 0x3f, 0x81,
+	0xad, 0xa3, 0x80, 0x79, 0xe9, 0x23, 0x62, 0xdc, 0x86, 0xe6, 0xa2, 0xa9, 0x75, 0x31, 0x80, 0xa7,
+	0x97, 0xaa, 0x4a, 0xd9, 0x56, 0x3e, 0x10, 0xe5, 0x9d, 0x61, 0xc8, 0x3f, 0x66, 0x18, 0x3a, 0xd0,
+	0xbe, 0x7b, 0x88, 0x0d, 0xe0, 0x7f, 0x0e, 0xd4, 0x17, 0x34, 0xe8, 0x3d, 0x7c, 0x22, 0xa4, 0x27,
+	0xb9, 0x90, 0x7c, 0xe0, 0x05, 0x6e, 0x3c, 0x96, 0x01, 0x67, 0xa9, 0x9b, 0xb2, 0x30, 0xbe, 0xf1,
+	0x02, 0xdb, 0xb6, 0x0e, 0xb9, 0x9c, 0xdb, 0x9c, 0x1b, 0x13, 0x6a, 0x2c, 0xe8, 0x8e, 0xb8, 0x4f,
+	0x85, 0xbe, 0x82, 0xed, 0xd4, 0xf3, 0xf9, 0x58, 0xdc, 0x71, 0x6b, 0x12, 0x69, 0x11, 0xaa, 0xd5,
+	0x4b, 0x1e, 0x9b, 0xe9, 0x0a, 0x14, 0xbd, 0x82, 0xba, 0xbe, 0xd8, 0xdd, 0x6b, 0x1e, 0x48, 0x96,
+	0x8a, 0x76, 0xc1, 0x4e, 0xae, 0xbe, 0xf5, 0x4f, 0x34, 0x48, 0x6b, 0xfe, 0x5c, 0x10, 0x78, 0x08,
+	0x3b, 0xf7, 0xc6, 0xad, 0xbe, 0x2d, 0x11, 0xe3, 0xc3, 0xd1, 0x55, 0x9c, 0x0a, 0x9d, 0x66, 0x89,
+	0xce, 0x01, 0xf4, 0x33, 0x40, 0x42, 0xfa, 0xae, 0xcf, 0x6e, 0xdc, 0x70, 0x1c, 0x48, 0x9e, 0xa8,
+	0x47, 0x75, 0xd8, 0x0e, 0x6d, 0x08, 0xe9, 0x1f, 0xb3, 0x9b, 0xb3, 0x19, 0x8e, 0x2f, 0xa1, 0xb9,
+	0x2a, 0x13, 0xb4, 0x0d, 0x65, 0x93, 0x8b, 0x3e, 0xc0, 0xa1, 0x56, 0x42, 0x3f, 0x82, 0x7a, 0xc8,
+	0x23, 0x77, 0x7e, 0xbe, 0xb9, 0x8d, 0x6b, 0x21, 0x8f, 0xfa, 0x53, 0x0c, 0xff, 0xd7, 0x81, 0x6a,
+	0x26, 0x37, 0xf4, 0x05, 0xac, 0x5d, 0xf1, 0xc0, 0x93, 0x2c, 0x9d, 0xf5, 0xa5, 0x41, 0x0e, 0xa7,
+	0x88, 0x31, 0x7a, 0x9b, 0xa3, 0x73, 0x23, 0xf4, 0x02, 0xca, 0x21, 0xf3, 0xb9, 0x17, 0xd9, 0x7a,
+	0xd7, 0xc9, 0x99, 0x16, 0x67, 0xb6, 0x56, 0x8d, 0x7e, 0x03, 0xf5, 0xeb, 0xe0, 0x03, 0x8f, 0x86,
+	0x6e, 0xc2, 0x27, 0x2c, 0x10, 0x96, 0xce, 0x20, 0x72, 0xa2, 0xd1, 0x0b, 0x05, 0xce, 0x1e, 0xaa,
+	0x5d, 0xcf, 0x41, 0xa1, 0x98, 0xc1, 0x28, 0x0e, 0x98, 0xea, 0x4a, 0xa0, 0x46, 0xb4, 0x68, 0xdf,
+	0xf3, 0xb7, 0x71, 0xc0, 0x4e, 0x0c, 0xf6, 0x36, 0x47, 0xab, 0xa3, 0xb9, 0x78, 0x58, 0x81, 0xb2,
+	0xe9, 0x21, 0x8e, 0x61, 0x63, 0x29, 0x81, 0xa5, 0x92, 0x95, 0xb2, 0x25, 0x13, 0x89, 0x27, 0xb9,
+	0x17, 0xb8, 0x82, 0x0f, 0x43, 0xcf, 0xf6, 0xa2, 0x66, 0xc1, 0x4b, 0x85, 0xa9, 0x0b, 0x3f, 0xf5,
+	0xa2, 0x21, 0xb3, 0x26, 0x05, 0x6d, 0x02, 0x1a, 0xd2, 0x06, 0xf8, 0x73, 0xa8, 0x65, 0x4b, 0x70,
+	0xdf, 0x69, 0xf8, 0x8f, 0xb0, 0x79, 0x27, 0x75, 0xf4, 0x12, 0x36, 0xd5, 0x07, 0x34, 0x65, 0x81,
+	0x27, 0xf9, 0x0d, 0x73, 0xbf, 0x1d, 0x87, 0x89, 0x6d, 0xec, 0x46, 0xe8, 0x4d, 0xa8, 0xc5, 0xbf,
+	0x1c, 0x87, 0x09, 0xfe, 0x12, 0xaa, 0x99, 0x0a, 0x3c, 0x94, 0xd5, 0xc7, 0x07, 0xe1, 0x3b, 0x28,
+	0x9d, 0xa4, 0xcb, 0x54, 0xc9, 0x79, 0x88, 0x2a, 0xe5, 0xef, 0xa5, 0x4a, 0x85, 0x15, 0x54, 0x09,
+	0xed, 0x40, 0x31, 0x89, 0x05, 0xb3, 0xbd, 0x2b, 0x91, 0x8b, 0x58, 0x30, 0xaa, 0x21, 0xfc, 0x2f,
+	0x07, 0x6a, 0x59, 0x92, 0x37, 0xb3, 0x75, 0xee, 0xd8, 0xa2, 0x67, 0x00, 0x86, 0x01, 0xb2, 0xd4,
+	0xd2, 0xa5, 0x0a, 0xcd, 0x20, 0xea, 0x8a, 0x4b, 0x43, 0xc1, 0x6c, 0x5b, 0xf4, 0x6f, 0xf5, 0x65,
+	0xe4, 0x91, 0x7a, 0x65, 0x84, 0x3e, 0xbd, 0x44, 0xa7, 0xa2, 0xf2, 0xc6, 0x25, 0x33, 0xa7, 0x0a,
+	0xfd, 0xd1, 0x2d, 0xd1, 0x0c, 0x82, 0x9f, 0x41, 0x51, 0x9d, 0xad, 0x4a, 0x1b, 0x7a, 0x32, 0xe5,
+	0x13, 0xfd, 0x29, 0x73, 0xa8, 0x95, 0xf0, 0x2b, 0x28, 0xe9, 0x2f, 0x34, 0xaa, 0x81, 0xf3, 0x67,
+	0x1d, 0x6e, 0x9e, 0x3a, 0x13, 0x25, 0x7d, 0xa3, 0x63, 0xcb, 0x53, 0xe7, 0x83, 0x92, 0xde, 0xeb,
+	0x78, 0xf2, 0xd4, 0xf9, 0x0e, 0xff, 0x3b, 0x0f, 0x25, 0x5d, 0x18, 0xd4, 0x86, 0x62, 0x1a, 0xdf,
+	0x4e, 0xbf, 0x8e, 0x45, 0x42, 0xe3, 0x5b, 0xaa, 0x11, 0xb4, 0x05, 0xa5, 0x89, 0x7b, 0x1d, 0xdf,
+	0x58, 0x1f, 0xc5, 0xc9, 0x49, 0x7c, 0xa3, 0xc0, 0x0f, 0x1a, 0x34, 0xae, 0x8a, 0x1f, 0x14, 0xf8,
+	0x53, 0xa8, 0xb0, 0x68, 0x10, 0xfb, 0xd3, 0xb7, 0x62, 0x7d, 0x7f, 0xc3, 0x94, 0x9d, 0x74, 0x2d,
+	0x4c, 0x67, 0x06, 0x8a, 0x0c, 0xa9, 0x41, 0x30, 0x4d, 0x2a, 0x69, 0x2f, 0x95, 0x90, 0x47, 0x26,
+	0x1a, 0xa5, 0xf4, 0x26, 0x56, 0x59, 0xb6, 0x4a, 0x6f, 0x62, 0x94, 0x3f, 0x86, 0x72, 0xe2, 0x0d,
+	0xfe, 0x6a, 0xa9, 0x85, 0xfe, 0xc4, 0x6a, 0xd1, 0x74, 0xd8, 0xea, 0x70, 0x00, 0x95, 0xe9, 0xa9,
+	0xa8, 0x0d, 0xcd, 0x6e, 0xff, 0xe8, 0xfc, 0xb8, 0xd7, 0x7f, 0xe3, 0x7e, 0xdd, 0xbf, 0xbc, 0xe8,
+	0x1e, 0xf5, 0x4e, 0x7a, 0xdd, 0xe3, 0x46, 0x0e, 0x6d, 0x40, 0xf5, 0xac, 0x77, 0x7a, 0xda, 0x3b,
+	0xeb, 0xbe, 0xeb, 0xd2, 0xcb, 0x86, 0x83, 0x9a, 0xd0, 0xf8, 0xaa, 0xd7, 0xef, 0x1e, 0xbd, 0x73,
+	0x8f, 0x7b, 0x97, 0x17, 0x07, 0xb4, 0xf7, 0xee, 0x9b, 0x46, 0x1e, 0x01, 0x94, 0xad, 0x45, 0x01,
+	0x6d, 0xc1, 0x46, 0xff, 0x9c, 0x9e, 0x1d, 0x9c, 0xf6, 0xde, 0x77, 0x8f, 0xdd, 0x5f, 0x1f, 0xf6,
+	0xde, 0x35, 0x8a, 0xf8, 0x3f, 0x0e, 0x54, 0x33, 0x51, 0x68, 0x6e, 0xc9, 0x7d, 0x39, 0xb2, 0xd3,
+	0x6f, 0x04, 0xd5, 0xb9, 0x91, 0x1a, 0x72, 0x69, 0xa7, 0xde, 0x4a, 0x2a, 0xdd, 0x2b, 0x2e, 0xdd,
+	0xf9, 0xc0, 0x96, 0x68, 0xe5, 0x8a, 0x4b, 0xe3, 0xea, 0xb7, 0x50, 0x1d, 0xc4, 0x61, 0x92, 0x32,
+	0x21, 0xd4, 0x22, 0x62, 0x0a, 0xdb, 0xce, 0xe6, 0x4c, 0x8e, 0xe6, 0x7a, 0x9a, 0x35, 0x56, 0x03,
+	0xe8, 0x7b, 0xd2, 0xd3, 0xf5, 0xad, 0x51, 0xfd, 0x1b, 0xbf, 0x84, 0x6a, 0xc6, 0x1e, 0x55, 0xa0,
+	0xd8, 0x3f, 0xef, 0x77, 0x1b, 0x39, 0xf5, 0xeb, 0xfd, 0x69, 0xef, 0xb0, 0xe1, 0xa0, 0x27, 0x50,
+	0xb8, 0xe8, 0xbf, 0x69, 0xe4, 0xf1, 0x6b, 0x28, 0xd0, 0xf8, 0x56, 0xc5, 0x7d, 0xe3, 0x05, 0x63,
+	0x66, 0xc6, 0xa3, 0x44, 0xad, 0xa4, 0x76, 0x81, 0xeb, 0x20, 0xf6, 0xa4, 0x6b, 0xb5, 0xf9, 0xdd,
+	0xc2, 0x5e, 0x9e, 0x56, 0x35, 0xf6, 0x27, 0x0d, 0xed, 0x7f, 0x5f, 0x82, 0xea, 0x19, 0x13, 0xa3,
+	0x43, 0xb3, 0x9e, 0xa2, 0xd7, 0x50, 0x5f, 0xd8, 0x08, 0x51, 0x8b, 0xac, 0xda, 0x53, 0x3b, 0xdb,
+	0x64, 0xe5, 0xe2, 0x88, 0x73, 0x08, 0x43, 0xe1, 0xc0, 0xf7, 0x51, 0x95, 0xcc, 0xd7, 0xbd, 0x4e,
+	0x8d, 0x64, 0x76, 0x37, 0x9c, 0x43, 0xaf, 0xa0, 0x32, 0x25, 0xf1, 0xa8, 0x41, 0x96, 0x96, 0x91,
+	0xce, 0x26, 0x59, 0xde, 0x3b, 0x70, 0x0e, 0xfd, 0x12, 0xd6, 0x66, 0xcb, 0x13, 0xda, 0x24, 0xcb,
+	0x9b, 0x5c, 0x07, 0x91, 0x3b, 0xbb, 0x15, 0xce, 0xed, 0x39, 0xa8, 0x0b, 0xeb, 0x8b, 0xfb, 0x02,
+	0xda, 0x26, 0x2b, 0x57, 0x94, 0xce, 0x53, 0xb2, 0x7a, 0xb1, 0xc0, 0xb9, 0x2f, 0x1c, 0xf4, 0x7b,
+	0xa8, 0x65, 0x99, 0x2b, 0x6a, 0x92, 0x15, 0xfc, 0xb6, 0xd3, 0x22, 0xab, 0xe8, 0x2d, 0xce, 0xa1,
+	0x5f, 0x01, 0xcc, 0xd9, 0x28, 0x42, 0xe4, 0x0e, 0x87, 0xed, 0x6c, 0x91, 0xbb, 0x74, 0x15, 0xe7,
+	0x54, 0x3f, 0x16, 0xc8, 0x25, 0x6a, 0x91, 0x55, 0xc4, 0xb4, 0xb3, 0x4d, 0x56, 0x73, 0x50, 0xed,
+	0x61, 0x81, 0x3e, 0xa2, 0x16, 0x59, 0xc5, 0x4a, 0x3b, 0xdb, 0x64, 0x35, 0xcb, 0xcc, 0xa9, 0xdc,
+	0xb3, 0xe4, 0x11, 0x35, 0xc9, 0x0a, 0xda, 0xd9, 0x69, 0x91, 0x95, 0x0c, 0x33, 0x87, 0x7a, 0xd0,
+	0x58, 0xa6, 0x7f, 0xa8, 0x4d, 0xee, 0xa1, 0x9d, 0x9d, 0x1d, 0x72, 0x2f, 0x57, 0xcc, 0x5d, 0x95,
+	0xf5, 0xbf, 0x27, 0x3f, 0xff, 0xff, 0x00, 0x9d, 0x4a, 0x6b, 0xb5, 0x52, 0x11, 0x00, 0x00,
 }
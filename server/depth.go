@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"io/ioutil"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+// Most pixels a packed depth frame may have. That's enough for 2048x2048, well
+// beyond what depth sensors capture, and bounds how much memory a small
+// compressed frame can make the server allocate.
+const maxPackedPixels = 2048 * 2048
+
+// depthImage holds a depth frame's raw values in row major order, regardless
+// of whether the frame was sent as rows or packed.
+type depthImage struct {
+	width, height int
+	values        []float64
+}
+
+func (img depthImage) at(row, col int) float64 {
+	return img.values[row*img.width+col]
+}
+
+// decodeDepth flattens a Depth frame into a depthImage. It returns an error if
+// the frame's rows are ragged or its packed data is malformed.
+func decodeDepth(d *pb.Depth) (depthImage, error) {
+	if d.GetPacked() != nil {
+		if d.Encoding == pb.Depth_METERS {
+			return depthImage{}, fieldErrorf("packed", "%v depth can't be packed. send it as rows of float_values", d.Encoding)
+		}
+		return unpackDepth(d.Packed)
+	}
+	if len(d.GetRows()) == 0 {
+		return depthImage{}, nil
+	}
+	img := depthImage{
+		width:  len(rowValues(d, d.Rows[0])),
+		height: len(d.Rows),
+	}
+	img.values = make([]float64, 0, img.width*img.height)
+	for i := range d.Rows {
+		values := rowValues(d, d.Rows[i])
+		if len(values) != img.width {
+			return depthImage{}, fieldErrorf(fmt.Sprintf("rows[%d]", i), "expected all rows in depth to be of equal size. got %v and %v", img.width, len(values))
+		}
+		img.values = append(img.values, values...)
+	}
+	return img, nil
+}
+
+func unpackDepth(p *pb.PackedDepth) (depthImage, error) {
+	img := depthImage{width: int(p.Width), height: int(p.Height)}
+	if img.width <= 0 || img.height <= 0 {
+		return depthImage{}, fieldErrorf("packed", "expected positive packed depth dimensions. got %v by %v", p.Width, p.Height)
+	}
+	if p.BitDepth != 8 && p.BitDepth != 16 {
+		return depthImage{}, fieldErrorf("packed.bit_depth", "expected packed depth bit_depth of 8 or 16. got %v", p.BitDepth)
+	}
+	if int64(img.width)*int64(img.height) > maxPackedPixels {
+		return depthImage{}, fieldErrorf("packed", "expected at most %v packed depth pixels. got %v by %v", maxPackedPixels, p.Width, p.Height)
+	}
+	n := img.width * img.height
+
+	if p.Compression == pb.PackedDepth_PNG {
+		// Check the size in the PNG's header before decoding, since decoding
+		// allocates an image of that size up front.
+		config, err := png.DecodeConfig(bytes.NewReader(p.Data))
+		if err != nil {
+			return depthImage{}, fieldErrorf("packed.data", "failed to decode packed depth PNG: %v", err)
+		}
+		if config.Width != img.width || config.Height != img.height {
+			return depthImage{}, fieldErrorf("packed.data", "expected a %v by %v packed depth PNG. got %v by %v", img.width, img.height, config.Width, config.Height)
+		}
+		decoded, err := png.Decode(bytes.NewReader(p.Data))
+		if err != nil {
+			return depthImage{}, fieldErrorf("packed.data", "failed to decode packed depth PNG: %v", err)
+		}
+		img.values = make([]float64, n)
+		switch pix := decoded.(type) {
+		case *image.Gray:
+			if p.BitDepth != 8 {
+				return depthImage{}, fieldErrorf("packed.data", "expected a %v bit packed depth PNG. got 8 bits", p.BitDepth)
+			}
+			for i := range img.values {
+				img.values[i] = float64(pix.GrayAt(pix.Rect.Min.X+i%img.width, pix.Rect.Min.Y+i/img.width).Y)
+			}
+		case *image.Gray16:
+			if p.BitDepth != 16 {
+				return depthImage{}, fieldErrorf("packed.data", "expected a %v bit packed depth PNG. got 16 bits", p.BitDepth)
+			}
+			for i := range img.values {
+				img.values[i] = float64(pix.Gray16At(pix.Rect.Min.X+i%img.width, pix.Rect.Min.Y+i/img.width).Y)
+			}
+		default:
+			return depthImage{}, fieldErrorf("packed.data", "expected a grayscale packed depth PNG. got %T", decoded)
+		}
+		return img, nil
+	}
+
+	bytesPerValue := int(p.BitDepth / 8)
+	data := p.Data
+	switch p.Compression {
+	case pb.PackedDepth_NONE:
+	case pb.PackedDepth_ZLIB:
+		r, err := zlib.NewReader(bytes.NewReader(p.Data))
+		if err != nil {
+			return depthImage{}, fieldErrorf("packed.data", "failed to decompress packed depth: %v", err)
+		}
+		// Read at most one byte more than expected, so that a small message
+		// can't decompress into an arbitrarily large buffer.
+		data, err = ioutil.ReadAll(io.LimitReader(r, int64(n*bytesPerValue+1)))
+		if err != nil {
+			return depthImage{}, fieldErrorf("packed.data", "failed to decompress packed depth: %v", err)
+		}
+	default:
+		return depthImage{}, fieldErrorf("packed.compression", "unknown packed depth compression: %v", p.Compression)
+	}
+	if len(data) != n*bytesPerValue {
+		return depthImage{}, fieldErrorf("packed.data", "expected %v bytes of packed depth for %v by %v at %v bits. got %v", n*bytesPerValue, img.width, img.height, p.BitDepth, len(data))
+	}
+	img.values = make([]float64, n)
+	for i := range img.values {
+		if bytesPerValue == 1 {
+			img.values[i] = float64(data[i])
+		} else {
+			img.values[i] = float64(binary.LittleEndian.Uint16(data[2*i:]))
+		}
+	}
+	return img, nil
+}
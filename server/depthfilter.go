@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+// maxFilterRadius bounds the windows of depth filters, whose cost grows with
+// the square of their radius.
+const maxFilterRadius = 10
+
+// depthFilter returns a filtered copy of a depth image whose values are in
+// meters, with 0 for pixels without a reading.
+type depthFilter func(img depthImage) depthImage
+
+// depthFilterFor returns the filter described by f, or a fieldError if it
+// can't be used. field is the path to f, which prefixes the error's field.
+func depthFilterFor(field string, f *pb.DepthFilter) (depthFilter, error) {
+	switch filter := f.GetFilter().(type) {
+	case *pb.DepthFilter_Bilateral:
+		b := filter.Bilateral
+		if err := validateFilterRadius(field+".bilateral.radius", b.Radius); err != nil {
+			return nil, err
+		}
+		if !(b.SpatialSigma > 0) || math.IsInf(b.SpatialSigma, 1) {
+			return nil, fieldErrorf(field+".bilateral.spatial_sigma", "expected a positive sigma. got %v", b.SpatialSigma)
+		}
+		if !(b.RangeSigma > 0) || math.IsInf(b.RangeSigma, 1) {
+			return nil, fieldErrorf(field+".bilateral.range_sigma", "expected a positive sigma. got %v", b.RangeSigma)
+		}
+		return func(img depthImage) depthImage {
+			return bilateralFilter(img, int(b.Radius), b.SpatialSigma, b.RangeSigma)
+		}, nil
+	case *pb.DepthFilter_Median:
+		if err := validateFilterRadius(field+".median.radius", filter.Median.Radius); err != nil {
+			return nil, err
+		}
+		return func(img depthImage) depthImage {
+			return medianFilter(img, int(filter.Median.Radius))
+		}, nil
+	case *pb.DepthFilter_FlyingPixels:
+		jump := filter.FlyingPixels.MaxRelativeJump
+		if !(jump > 0) || math.IsInf(jump, 1) {
+			return nil, fieldErrorf(field+".flying_pixels.max_relative_jump", "expected a positive fraction. got %v", jump)
+		}
+		return func(img depthImage) depthImage {
+			return removeFlyingPixels(img, jump)
+		}, nil
+	case *pb.DepthFilter_HoleFilling:
+		h := filter.HoleFilling
+		if err := validateFilterRadius(field+".hole_filling.radius", h.Radius); err != nil {
+			return nil, err
+		}
+		if h.MinNeighbors <= 0 {
+			return nil, fieldErrorf(field+".hole_filling.min_neighbors", "expected a positive number of neighbors. got %d", h.MinNeighbors)
+		}
+		return func(img depthImage) depthImage {
+			return fillHoles(img, int(h.Radius), int(h.MinNeighbors))
+		}, nil
+	case nil:
+		return nil, fieldErrorf(field, "expected a filter")
+	default:
+		return nil, fieldErrorf(field, "unknown filter: %T", filter)
+	}
+}
+
+func validateFilterRadius(field string, radius int32) error {
+	if radius < 1 || radius > maxFilterRadius {
+		return fieldErrorf(field, "expected a radius between 1 and %d. got %d", maxFilterRadius, radius)
+	}
+	return nil
+}
+
+// depthFiltersFor returns the filters described by filters, in order, or a
+// fieldError for the first one that can't be used.
+func depthFiltersFor(filters []*pb.DepthFilter) ([]depthFilter, error) {
+	var fs []depthFilter
+	for i, f := range filters {
+		filter, err := depthFilterFor(fmt.Sprintf("depth_filters[%d]", i), f)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, filter)
+	}
+	return fs, nil
+}
+
+// neighbors calls f with the depth of every pixel with a reading within radius
+// rows and columns of (row, col), including the pixel itself, along with its
+// offset.
+func (img depthImage) neighbors(row, col, radius int, f func(dr, dc int, z float64)) {
+	for r := row - radius; r <= row+radius; r++ {
+		if r < 0 || r >= img.height {
+			continue
+		}
+		for c := col - radius; c <= col+radius; c++ {
+			if c < 0 || c >= img.width {
+				continue
+			}
+			if z := img.at(r, c); z > 0 {
+				f(r-row, c-col, z)
+			}
+		}
+	}
+}
+
+func bilateralFilter(img depthImage, radius int, spatialSigma, rangeSigma float64) depthImage {
+	out := depthImage{width: img.width, height: img.height, values: make([]float64, len(img.values))}
+	for row := 0; row < img.height; row++ {
+		for col := 0; col < img.width; col++ {
+			center := img.at(row, col)
+			if center <= 0 {
+				continue
+			}
+			var total, weights float64
+			img.neighbors(row, col, radius, func(dr, dc int, z float64) {
+				w := math.Exp(-float64(dr*dr+dc*dc)/(2*spatialSigma*spatialSigma) - (z-center)*(z-center)/(2*rangeSigma*rangeSigma))
+				total += w * z
+				weights += w
+			})
+			out.values[row*img.width+col] = total / weights
+		}
+	}
+	return out
+}
+
+func medianFilter(img depthImage, radius int) depthImage {
+	out := depthImage{width: img.width, height: img.height, values: make([]float64, len(img.values))}
+	var window []float64
+	for row := 0; row < img.height; row++ {
+		for col := 0; col < img.width; col++ {
+			if img.at(row, col) <= 0 {
+				continue
+			}
+			window = window[:0]
+			img.neighbors(row, col, radius, func(_, _ int, z float64) {
+				window = append(window, z)
+			})
+			out.values[row*img.width+col] = median(window)
+		}
+	}
+	return out
+}
+
+func removeFlyingPixels(img depthImage, maxRelativeJump float64) depthImage {
+	out := depthImage{width: img.width, height: img.height, values: append([]float64(nil), img.values...)}
+	for row := 0; row < img.height; row++ {
+		for col := 0; col < img.width; col++ {
+			center := img.at(row, col)
+			if center <= 0 {
+				continue
+			}
+			flying := false
+			img.neighbors(row, col, 1, func(_, _ int, z float64) {
+				if math.Abs(z-center) > maxRelativeJump*center {
+					flying = true
+				}
+			})
+			if flying {
+				out.values[row*img.width+col] = 0
+			}
+		}
+	}
+	return out
+}
+
+func fillHoles(img depthImage, radius, minNeighbors int) depthImage {
+	out := depthImage{width: img.width, height: img.height, values: append([]float64(nil), img.values...)}
+	var window []float64
+	for row := 0; row < img.height; row++ {
+		for col := 0; col < img.width; col++ {
+			if img.at(row, col) > 0 {
+				continue
+			}
+			window = window[:0]
+			img.neighbors(row, col, radius, func(_, _ int, z float64) {
+				window = append(window, z)
+			})
+			if len(window) >= minNeighbors {
+				out.values[row*img.width+col] = median(window)
+			}
+		}
+	}
+	return out
+}
+
+// median sorts values in place and returns their median. values must not be
+// empty.
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
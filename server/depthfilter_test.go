@@ -0,0 +1,144 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flatImage returns a width by height image whose every pixel is z meters away.
+func flatImage(width, height int, z float64) depthImage {
+	img := depthImage{width: width, height: height, values: make([]float64, width*height)}
+	for i := range img.values {
+		img.values[i] = z
+	}
+	return img
+}
+
+// stepImage returns a 6x4 image of a surface a meter away whose right half
+// steps back to 3 meters, like the edge of an object in front of a wall.
+func stepImage() depthImage {
+	img := flatImage(6, 4, 1)
+	for row := 0; row < img.height; row++ {
+		for col := 3; col < img.width; col++ {
+			img.values[row*img.width+col] = 3
+		}
+	}
+	return img
+}
+
+func TestMedianFilter(t *testing.T) {
+	img := flatImage(5, 5, 1)
+	img.values[12] = 3
+	img.values[0] = 0
+	out := medianFilter(img, 1)
+	if got := out.at(2, 2); got != 1 {
+		t.Errorf("got %v for a spike in a flat image. want 1", got)
+	}
+	if got := out.at(0, 0); got != 0 {
+		t.Errorf("got %v for a pixel without a reading. want it left at 0", got)
+	}
+}
+
+func TestFillHoles(t *testing.T) {
+	img := flatImage(5, 5, 2)
+	img.values[12] = 0
+	if got := fillHoles(img, 1, 8).at(2, 2); got != 2 {
+		t.Errorf("got %v for a hole surrounded by pixels 2 meters away. want 2", got)
+	}
+
+	img = flatImage(5, 5, 0)
+	img.values[0] = 1
+	if got := fillHoles(img, 1, 2).at(1, 1); got != 0 {
+		t.Errorf("got %v for a hole next to a single reading. want it left at 0", got)
+	}
+}
+
+func TestRemoveFlyingPixels(t *testing.T) {
+	out := removeFlyingPixels(stepImage(), 0.1)
+	for row := 0; row < out.height; row++ {
+		// Pixels on either side of the step are masked. Those farther from it
+		// are kept.
+		want := []float64{1, 1, 0, 0, 3, 3}
+		for col := range want {
+			if got := out.at(row, col); got != want[col] {
+				t.Errorf("got row %d: %v. want %v", row, out.values[row*out.width:(row+1)*out.width], want)
+				break
+			}
+		}
+	}
+}
+
+func TestBilateralFilterPreservesEdges(t *testing.T) {
+	img := stepImage()
+	img.values[1*img.width+1] = 1.02
+	out := bilateralFilter(img, 2, 1, 0.05)
+	if got := out.at(1, 1); got >= 1.02 || got <= 1 {
+		t.Errorf("got %v for a bump of 1.02 on a surface at 1. want it smoothed toward 1", got)
+	}
+	if got := out.at(1, 2); math.Abs(got-1) > 0.01 {
+		t.Errorf("got %v next to the step on the near side. want about 1", got)
+	}
+	if got := out.at(1, 3); math.Abs(got-3) > 1e-6 {
+		t.Errorf("got %v next to the step on the far side. want 3", got)
+	}
+}
+
+func TestSetPreprocessingInvalidDepthFilter(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	median := &pb.DepthFilter{Filter: &pb.DepthFilter_Median{Median: &pb.MedianFilter{Radius: 1}}}
+	for _, tc := range []struct {
+		desc   string
+		filter *pb.DepthFilter
+		field  string
+	}{
+		{"no filter", &pb.DepthFilter{}, "preprocessing.depth_filters[1]"},
+		{"no range sigma", &pb.DepthFilter{Filter: &pb.DepthFilter_Bilateral{Bilateral: &pb.BilateralFilter{Radius: 2, SpatialSigma: 1}}}, "preprocessing.depth_filters[1].bilateral.range_sigma"},
+		{"radius too large", &pb.DepthFilter{Filter: &pb.DepthFilter_HoleFilling{HoleFilling: &pb.HoleFilling{Radius: maxFilterRadius + 1, MinNeighbors: 1}}}, "preprocessing.depth_filters[1].hole_filling.radius"},
+	} {
+		_, err := s.SetPreprocessing(ctx, &pb.SetPreprocessingRequest{Name: "p", Preprocessing: &pb.Preprocessing{
+			DepthFilters: []*pb.DepthFilter{median, tc.filter},
+		}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got %v. want %v", tc.desc, err, codes.InvalidArgument)
+			continue
+		}
+		if got := badRequestField(err); got != tc.field {
+			t.Errorf("%s: got field %q. want %q", tc.desc, got, tc.field)
+		}
+	}
+}
+
+func TestAddAppliesDepthFilters(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	holey := testFrame(5, 5, 1000)
+	holey.Rows[2].Values[2] = 0
+	if _, err := s.Add(ctx, &pb.AddRequest{Name: "p", Depth: holey}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := s.SetPreprocessing(ctx, &pb.SetPreprocessingRequest{Name: "p", Preprocessing: &pb.Preprocessing{
+		DepthFilters: []*pb.DepthFilter{{Filter: &pb.DepthFilter_HoleFilling{HoleFilling: &pb.HoleFilling{Radius: 1, MinNeighbors: 4}}}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Add(ctx, &pb.AddRequest{Name: "p", Depth: holey}); err != nil {
+		t.Fatal(err)
+	}
+	// The first frame is missing its middle pixel. The second has it filled.
+	if got := projectInfo(t, s, "p").PointCount; got != 24+25 {
+		t.Errorf("got %d points. want %d", got, 24+25)
+	}
+}
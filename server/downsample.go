@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/gonum/matrix/mat64"
+	algorithms "github.com/omustardo/scanner/algorithms"
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+var (
+	frameVoxelSize   = flag.Float64("frame_voxel_size", 0, "Size, in meters, of the cubes that each frame is downsampled to one point per before it's registered and stored. If 0, frames keep every point.")
+	frameVoxelMode   = flag.String("frame_voxel_mode", "centroid", "How the points in each cube are combined when downsampling frames. Either centroid, which averages them, or nearest, which keeps the one nearest their average.")
+	projectVoxelSize = flag.Float64("project_voxel_size", 0, "Size, in meters, of the cubes that a project keeps at most one point per: the first one added in each. Points from new frames that land in a cube the project already has a point in are left out of the project's points. If 0, projects keep every point.")
+)
+
+var voxelModes = map[string]algorithms.VoxelMode{
+	"centroid": algorithms.VoxelCentroid,
+	"nearest":  algorithms.VoxelNearestToCentroid,
+}
+
+// downsampleFrame returns points downsampled according to the frame_voxel_size
+// and frame_voxel_mode flags.
+func downsampleFrame(points []*pb.Point) []*pb.Point {
+	if *frameVoxelSize <= 0 {
+		return points
+	}
+	// The flags are validated on startup, so this can't fail.
+	filtered, _ := algorithms.VoxelGridFilter(toDense(points), *frameVoxelSize, voxelModes[*frameVoxelMode])
+	return fromDense(filtered)
+}
+
+// fromDense returns the points in a 3xN matrix, with one point per column.
+func fromDense(m *mat64.Dense) []*pb.Point {
+	_, c := m.Dims()
+	points := make([]*pb.Point, c)
+	for j := range points {
+		points[j] = &pb.Point{X: float32(m.At(0, j)), Y: float32(m.At(1, j)), Z: float32(m.At(2, j))}
+	}
+	return points
+}
+
+// projectVoxel returns the cube that pt is in, of those the project_voxel_size
+// flag divides space into.
+func projectVoxel(pt *pb.Point) algorithms.Voxel {
+	return algorithms.VoxelOf([]float64{float64(pt.X), float64(pt.Y), float64(pt.Z)}, *projectVoxelSize)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+	"golang.org/x/net/context"
+)
+
+func TestProjectVoxelsKeepFirstPoint(t *testing.T) {
+	defer func(size float64) { *projectVoxelSize = size }(*projectVoxelSize)
+	*projectVoxelSize = 1
+
+	p := newProject(newProjectID(), "p", time.Now())
+	for _, tc := range []struct {
+		points, want []*pb.Point
+	}{
+		{
+			points: []*pb.Point{{X: 0.9, Y: 0.9, Z: 0.9}, {X: 0.5, Y: 0.5, Z: 0.5}, {X: 1.5}},
+			want:   []*pb.Point{{X: 0.9, Y: 0.9, Z: 0.9}, {X: 1.5}},
+		},
+		{
+			points: []*pb.Point{{X: 0.1, Y: 0.1, Z: 0.1}, {X: -0.5}, {X: 1.9}},
+			want:   []*pb.Point{{X: -0.5}},
+		},
+	} {
+		added := p.newPoints(tc.points)
+		p.add(&frame{points: tc.points}, added, time.Now())
+		if len(added) != len(tc.want) {
+			t.Errorf("added %v. want %v", added, tc.want)
+			continue
+		}
+		for i := range added {
+			if *added[i] != *tc.want[i] {
+				t.Errorf("added %v. want %v", added, tc.want)
+				break
+			}
+		}
+	}
+	if len(p.points) != 3 || len(p.frames) != 2 {
+		t.Errorf("got %d points in %d frames. want 3 in 2", len(p.points), len(p.frames))
+	}
+}
+
+func TestProjectVoxelsCountTowardLimit(t *testing.T) {
+	defer func(size float64, limit int) { *projectVoxelSize, *maxProjectPoints = size, limit }(*projectVoxelSize, *maxProjectPoints)
+	*projectVoxelSize = 0.5
+	*maxProjectPoints = 20
+
+	s := newTestServer()
+	ctx := context.Background()
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	// Every frame lands in the same cubes, so only the first adds points.
+	for i := 0; i < 3; i++ {
+		if _, err := s.Add(ctx, &pb.AddRequest{Name: "p", Depth: testFrame(8, 6, 1000)}); err != nil {
+			t.Fatalf("adding frame %d: %v", i, err)
+		}
+	}
+	resp, err := s.GetProject(ctx, &pb.GetProjectRequest{Name: "p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Project.FrameCount != 3 || resp.Project.PointCount == 0 || resp.Project.PointCount > 20 {
+		t.Errorf("got %d frames and %d points. want 3 frames, and at most 20 points", resp.Project.FrameCount, resp.Project.PointCount)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"math"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+const (
+	millimetersPerMeter = 1000
+
+	// Raw disparity reported by the Kinect v1 when it can't see its projected
+	// pattern at a pixel.
+	kinectInvalidDisparity = 2047
+	// Farthest the Kinect v1 can measure, in meters. Its disparity curve keeps
+	// going well past this, but readings out there are noise.
+	kinectMaxDepth = 8
+
+	// Largest value that can be sent with the NORMALIZED_8BIT encoding.
+	maxNormalized8Bit = 255
+)
+
+// depthConverter turns a single raw value from a Depth frame into meters. ok is
+// false when the value doesn't represent a valid reading and the pixel should
+// be skipped.
+type depthConverter func(raw float64) (meters float64, ok bool)
+
+// converterFor returns the depthConverter matching the Depth frame's encoding.
+func converterFor(d *pb.Depth) (depthConverter, error) {
+	switch d.GetEncoding() {
+	case pb.Depth_ENCODING_UNSPECIFIED:
+		return nil, fieldErrorf("encoding", "expected a depth encoding")
+	case pb.Depth_MILLIMETERS:
+		return millimetersToMeters, nil
+	case pb.Depth_KINECT_DISPARITY:
+		return kinectDisparityToMeters, nil
+	case pb.Depth_METERS:
+		return metersToMeters, nil
+	case pb.Depth_NORMALIZED_8BIT:
+		min, max := float64(d.MinDepth), float64(d.MaxDepth)
+		if min < 0 || max <= min {
+			return nil, fieldErrorf("max_depth", "expected 0 <= min_depth < max_depth for %v. got %v and %v", d.Encoding, min, max)
+		}
+		return func(raw float64) (float64, bool) {
+			if raw <= 0 || raw > maxNormalized8Bit {
+				return 0, false
+			}
+			return min + (raw-1)/(maxNormalized8Bit-1)*(max-min), true
+		}, nil
+	}
+	return nil, fieldErrorf("encoding", "unknown depth encoding: %v", d.Encoding)
+}
+
+// rowValues returns the raw values of a row as float64s, reading whichever of
+// the row's fields the frame's encoding uses.
+func rowValues(d *pb.Depth, r *pb.Row) []float64 {
+	var values []float64
+	if d.GetEncoding() == pb.Depth_METERS {
+		values = make([]float64, len(r.GetFloatValues()))
+		for i, v := range r.GetFloatValues() {
+			values[i] = float64(v)
+		}
+		return values
+	}
+	values = make([]float64, len(r.GetValues()))
+	for i, v := range r.GetValues() {
+		values[i] = float64(v)
+	}
+	return values
+}
+
+func millimetersToMeters(raw float64) (float64, bool) {
+	if raw <= 0 {
+		return 0, false
+	}
+	return raw / millimetersPerMeter, true
+}
+
+func metersToMeters(raw float64) (float64, bool) {
+	if raw <= 0 || math.IsInf(raw, 0) || math.IsNaN(raw) {
+		return 0, false
+	}
+	return raw, true
+}
+
+// Converts raw 11-bit Kinect v1 disparity to meters using the calibration from
+// http://nicolas.burrus.name/index.php/Research/KinectCalibration
+// 0 and kinectInvalidDisparity mean the sensor got no reading. Disparities
+// past ~1043 are beyond kinectMaxDepth, and are treated the same way.
+func kinectDisparityToMeters(raw float64) (float64, bool) {
+	if raw <= 0 || raw >= kinectInvalidDisparity {
+		return 0, false
+	}
+	inverseDepth := raw*-0.0030711016 + 3.3309495161
+	if inverseDepth < 1.0/kinectMaxDepth {
+		return 0, false
+	}
+	return 1 / inverseDepth, true
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKinectDisparityToMeters(t *testing.T) {
+	for _, tc := range []struct {
+		raw    float64
+		meters float64
+		ok     bool
+	}{
+		{0, 0, false},
+		{kinectInvalidDisparity, 0, false},
+		{-1, 0, false},
+		{400, 0.4756, true},
+		{800, 1.1441, true},
+		{1030, 5.9625, true},
+		// On the calibration curve, but beyond kinectMaxDepth: 9.4 and 202
+		// meters away.
+		{1050, 0, false},
+		{1083, 0, false},
+		// Past the end of the curve, where depth would be negative.
+		{1090, 0, false},
+	} {
+		meters, ok := kinectDisparityToMeters(tc.raw)
+		if ok != tc.ok || math.Abs(meters-tc.meters) > 1e-3 {
+			t.Errorf("kinectDisparityToMeters(%v) = %v, %v. want %v, %v", tc.raw, meters, ok, tc.meters, tc.ok)
+		}
+	}
+}
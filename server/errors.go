@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fieldError is an error caused by the value of a single request field.
+type fieldError struct {
+	// Path to the field, like "rows[2].values". Empty if the whole message is
+	// at fault.
+	field       string
+	description string
+}
+
+func (e *fieldError) Error() string { return e.description }
+
+func fieldErrorf(field, format string, a ...interface{}) error {
+	return &fieldError{field: field, description: fmt.Sprintf(format, a...)}
+}
+
+// invalidArgument returns an InvalidArgument status for err. If err is a
+// fieldError, the field is reported in a BadRequest detail, prefixed with
+// parent, the path to the message holding the field.
+func invalidArgument(parent string, err error) error {
+	fe, ok := err.(*fieldError)
+	if !ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	field := fe.field
+	switch {
+	case field == "":
+		field = parent
+	case parent != "":
+		field = parent + "." + field
+	}
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, fe.description))
+	detailed, derr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: fe.description}},
+	})
+	if derr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func projectNotFound(name string) error {
+	return status.Errorf(codes.NotFound, "unknown project: %q", name)
+}
+
+func projectExists(name string) error {
+	return status.Errorf(codes.AlreadyExists, "project already exists with name %q", name)
+}
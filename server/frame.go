@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+// frame is a depth frame as it was added to a project. The raw depth is kept
+// so that the frame can be reprocessed later.
+type frame struct {
+	// Zero for frames added with Add.
+	sequence  uint64
+	timestamp time.Time
+	depth     *pb.Depth
+	// The camera that captured depth.
+	intrinsics intrinsics
+	pose       pose
+	// Points derived from depth, in world space.
+	points []*pb.Point
+}
+
+func (f *frame) toProto() *pb.Frame {
+	var timestamp int64
+	if !f.timestamp.IsZero() {
+		timestamp = f.timestamp.UnixNano()
+	}
+	return &pb.Frame{
+		Sequence:  f.sequence,
+		Timestamp: timestamp,
+		Depth:     f.depth,
+		Pose:      f.pose.toProto(),
+	}
+}
+
+// pose is a rigid transform from a frame's camera space into the project's
+// world space, as a 4x4 matrix in row major order.
+type pose [16]float64
+
+var identityPose = pose{
+	1, 0, 0, 0,
+	0, 1, 0, 0,
+	0, 0, 1, 0,
+	0, 0, 0, 1,
+}
+
+// poseFromProto returns the pose held by p, or identityPose if p is unset.
+func poseFromProto(p *pb.Pose) (pose, error) {
+	if len(p.GetMatrix()) == 0 {
+		return identityPose, nil
+	}
+	var m pose
+	if len(p.Matrix) != len(m) {
+		return pose{}, fmt.Errorf("expected a pose with %d values. got %d", len(m), len(p.Matrix))
+	}
+	copy(m[:], p.Matrix)
+	return m, nil
+}
+
+func (m pose) toProto() *pb.Pose {
+	return &pb.Pose{Matrix: append([]float64(nil), m[:]...)}
+}
+
+// apply returns pt transformed by the pose.
+func (m pose) apply(pt *pb.Point) *pb.Point {
+	x, y, z := float64(pt.X), float64(pt.Y), float64(pt.Z)
+	return &pb.Point{
+		X: float32(m[0]*x + m[1]*y + m[2]*z + m[3]),
+		Y: float32(m[4]*x + m[5]*y + m[6]*z + m[7]),
+		Z: float32(m[8]*x + m[9]*y + m[10]*z + m[11]),
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"log"
+	"math"
+
+	algorithms "github.com/omustardo/scanner/algorithms"
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+// validatePreprocessing returns a fieldError for the first setting in p that
+// can't be used. A nil p is valid, and means frames are used as they are.
+func validatePreprocessing(p *pb.Preprocessing) error {
+	if sor := p.GetStatisticalOutlierRemoval(); sor != nil {
+		if sor.Neighbors <= 0 {
+			return fieldErrorf("statistical_outlier_removal.neighbors", "expected a positive number of neighbors. got %d", sor.Neighbors)
+		}
+		if math.IsNaN(sor.StdDevMultiplier) || math.IsInf(sor.StdDevMultiplier, 0) {
+			return fieldErrorf("statistical_outlier_removal.std_dev_multiplier", "expected a finite multiplier. got %v", sor.StdDevMultiplier)
+		}
+	}
+	if ror := p.GetRadiusOutlierRemoval(); ror != nil {
+		if !(ror.Radius > 0) || math.IsInf(ror.Radius, 1) {
+			return fieldErrorf("radius_outlier_removal.radius", "expected a positive radius. got %v", ror.Radius)
+		}
+		if ror.MinNeighbors < 0 {
+			return fieldErrorf("radius_outlier_removal.min_neighbors", "expected a non-negative number of neighbors. got %d", ror.MinNeighbors)
+		}
+	}
+	if _, err := depthFiltersFor(p.GetDepthFilters()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// preprocess returns the camera space points of a frame with the outlier
+// removal steps in p applied, in the order they're declared in the
+// Preprocessing message. Its depth filters are applied by processDepth.
+func preprocess(points []*pb.Point, p *pb.Preprocessing) []*pb.Point {
+	if sor := p.GetStatisticalOutlierRemoval(); sor != nil {
+		points = filterPoints(points, func(a *algorithms.PointCloudAnalyzer) ([]int, error) {
+			return a.StatisticalOutlierRemoval(int(sor.Neighbors), sor.StdDevMultiplier)
+		})
+	}
+	if ror := p.GetRadiusOutlierRemoval(); ror != nil {
+		points = filterPoints(points, func(a *algorithms.PointCloudAnalyzer) ([]int, error) {
+			return a.RadiusOutlierRemoval(ror.Radius, int(ror.MinNeighbors))
+		})
+	}
+	return points
+}
+
+// filterPoints returns the points whose indices are returned by filter, which
+// is given an analyzer holding the points.
+func filterPoints(points []*pb.Point, filter func(*algorithms.PointCloudAnalyzer) ([]int, error)) []*pb.Point {
+	if len(points) == 0 {
+		return points
+	}
+	a := &algorithms.PointCloudAnalyzer{}
+	a.MakePointCloudAnalyzer(toDense(points))
+	keep, err := filter(a)
+	if err != nil {
+		// Settings are validated before they're stored, so this shouldn't
+		// happen. Keeping every point is the safest way to carry on.
+		log.Println("Failed to preprocess frame:", err)
+		return points
+	}
+	filtered := make([]*pb.Point, len(keep))
+	for i, col := range keep {
+		filtered[i] = points[col]
+	}
+	return filtered
+}
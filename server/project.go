@@ -0,0 +1,173 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math"
+	"sync"
+	"time"
+
+	algorithms "github.com/omustardo/scanner/algorithms"
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+type project struct {
+	// Guards the fields below. It's held while a frame is stored and appended
+	// so that frames from concurrent Add calls are never interleaved or lost.
+	mu sync.Mutex
+	id string
+	// The project's current name. It changes if the project is renamed.
+	name string
+	// Frames in the order they were added.
+	frames []*frame
+	// Points from every frame, in world space. Points and frames are only ever
+	// appended, or replaced by new slices when the project is cleared, so a
+	// slice read while holding mu can be used after releasing it.
+	points []*pb.Point
+	// Bounds of points. Only meaningful if there are points.
+	min, max pb.Point
+	// Cubes that points are in, if the project_voxel_size flag is set.
+	voxels map[algorithms.Voxel]bool
+	// How frames are cleaned up before they're added. Never modified, only
+	// replaced, so it can be used after releasing mu.
+	preprocessing *pb.Preprocessing
+
+	created, updated time.Time
+	// Set once the project is deleted, after which nothing may be added to it.
+	deleted bool
+	// Incremented each time the project is cleared.
+	clears int
+	// Closed and cleared when points are added, or the project is cleared or
+	// deleted. Created lazily by changes().
+	changed chan struct{}
+}
+
+func newProject(id, name string, created time.Time) *project {
+	return &project{id: id, name: name, created: created, updated: created}
+}
+
+// newProjectID returns a random ID for a new project.
+func newProjectID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// add appends a frame to the project and wakes up anything watching it.
+// points are the frame's points that the project keeps, as returned by
+// newPoints. p.mu must be held, and not released since calling newPoints.
+func (p *project) add(f *frame, points []*pb.Point, now time.Time) {
+	if *projectVoxelSize > 0 {
+		if p.voxels == nil {
+			p.voxels = make(map[algorithms.Voxel]bool)
+		}
+		for _, pt := range points {
+			p.voxels[projectVoxel(pt)] = true
+		}
+	}
+	p.extendBounds(points)
+	p.frames = append(p.frames, f)
+	p.points = append(p.points, points...)
+	p.updated = now
+	p.notify()
+}
+
+// registrationTarget returns the most recent frame that has points, or nil if
+// there isn't one. New frames are registered against it, since a frame whose
+// pixels were all missing or filtered out has nothing to align with. p.mu must
+// be held.
+func (p *project) registrationTarget() *frame {
+	for i := len(p.frames) - 1; i >= 0; i-- {
+		if len(p.frames[i].points) > 0 {
+			return p.frames[i]
+		}
+	}
+	return nil
+}
+
+// newPoints returns the points that adding a frame with the given points would
+// add to the project's points. That's all of them unless the
+// project_voxel_size flag is set, in which case it's the first of them in each
+// cube the project doesn't have a point in yet. The first point wins, rather
+// than the cube's centroid, because the project's points are only ever
+// appended to: a point that's been retrieved by a client is never moved.
+// p.mu must be held.
+func (p *project) newPoints(points []*pb.Point) []*pb.Point {
+	if *projectVoxelSize <= 0 {
+		return points
+	}
+	var added []*pb.Point
+	seen := make(map[algorithms.Voxel]bool)
+	for _, pt := range points {
+		v := projectVoxel(pt)
+		if p.voxels[v] || seen[v] {
+			continue
+		}
+		seen[v] = true
+		added = append(added, pt)
+	}
+	return added
+}
+
+func (p *project) extendBounds(points []*pb.Point) {
+	for i, pt := range points {
+		if len(p.points) == 0 && i == 0 {
+			p.min, p.max = *pt, *pt
+			continue
+		}
+		p.min.X = float32(math.Min(float64(p.min.X), float64(pt.X)))
+		p.min.Y = float32(math.Min(float64(p.min.Y), float64(pt.Y)))
+		p.min.Z = float32(math.Min(float64(p.min.Z), float64(pt.Z)))
+		p.max.X = float32(math.Max(float64(p.max.X), float64(pt.X)))
+		p.max.Y = float32(math.Max(float64(p.max.Y), float64(pt.Y)))
+		p.max.Z = float32(math.Max(float64(p.max.Z), float64(pt.Z)))
+	}
+}
+
+// clear removes every frame and point from the project. p.mu must be held.
+func (p *project) clear(now time.Time) {
+	p.frames = nil
+	p.points = nil
+	p.voxels = nil
+	p.updated = now
+	p.clears++
+	p.notify()
+}
+
+// info summarizes the project. p.mu must be held.
+func (p *project) info() *pb.ProjectInfo {
+	info := &pb.ProjectInfo{
+		Id:            p.id,
+		Name:          p.name,
+		FrameCount:    int64(len(p.frames)),
+		PointCount:    int64(len(p.points)),
+		Created:       p.created.UnixNano(),
+		Updated:       p.updated.UnixNano(),
+		Preprocessing: p.preprocessing,
+	}
+	if len(p.points) > 0 {
+		min, max := p.min, p.max
+		info.BoundsMin, info.BoundsMax = &min, &max
+	}
+	return info
+}
+
+// changes returns a channel that's closed the next time the project changes.
+// p.mu must be held.
+func (p *project) changes() <-chan struct{} {
+	if p.changed == nil {
+		p.changed = make(chan struct{})
+	}
+	return p.changed
+}
+
+// notify wakes up everything waiting on a channel from changes(). p.mu must be
+// held.
+func (p *project) notify() {
+	if p.changed != nil {
+		close(p.changed)
+		p.changed = nil
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/gonum/matrix/mat64"
+	algorithms "github.com/omustardo/scanner/algorithms"
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+var (
+	register                  = flag.Bool("register", true, "Whether to align each frame with the frame added before it. If false, every frame is assumed to be captured from the same pose.")
+	registrationMetric        = flag.String("registration_metric", "point_to_plane", "Error minimized when aligning frames. Either point_to_plane or point_to_point.")
+	maxCorrespondenceDistance = flag.Float64("max_correspondence_distance", 0.1, "Farthest apart, in meters, that two points in consecutive frames may be and still be considered the same point when aligning frames.")
+)
+
+var registrationMetrics = map[string]algorithms.ICPMetric{
+	"point_to_plane": algorithms.PointToPlane,
+	"point_to_point": algorithms.PointToPoint,
+}
+
+const (
+	// Frames are subsampled to at most this many points when registering, to
+	// bound the time spent estimating normals and finding correspondences.
+	registrationSourcePoints = 1000
+	registrationTargetPoints = 5000
+)
+
+// registration is the result of aligning a frame with its project.
+type registration struct {
+	pose       pose
+	registered bool
+	rmse       float64
+	inliers    int
+	iterations int
+}
+
+func (r registration) toProto() *pb.Registration {
+	return &pb.Registration{
+		Pose:       r.pose.toProto(),
+		Registered: r.registered,
+		Rmse:       r.rmse,
+		Inliers:    int32(r.inliers),
+		Iterations: int32(r.iterations),
+	}
+}
+
+// registerFrame estimates the pose of a new frame by aligning its camera space
+// points with the world space points of prev, the last frame added before it
+// that has points. It uses ICP, starting from prev's pose since the sensor
+// usually moves little between frames. If prev is nil, the new frame defines
+// world space.
+func registerFrame(points []*pb.Point, prev *frame) registration {
+	if prev == nil {
+		return registration{pose: identityPose}
+	}
+	failed := registration{pose: prev.pose}
+	if !*register {
+		return failed
+	}
+	opts := algorithms.DefaultICPOptions()
+	opts.Metric = registrationMetrics[*registrationMetric]
+	opts.MaxCorrespondenceDistance = *maxCorrespondenceDistance
+	opts.Initial = prev.pose.toDense()
+	src := toDense(samplePoints(points, registrationSourcePoints))
+	dst := toDense(samplePoints(prev.points, registrationTargetPoints))
+	result, err := algorithms.ICP(src, dst, opts)
+	if err != nil {
+		log.Println("Failed to register frame:", err)
+		return failed
+	}
+	return registration{
+		pose:       poseFromDense(result.Transform),
+		registered: true,
+		rmse:       result.RMSE,
+		inliers:    result.Inliers,
+		iterations: result.Iterations,
+	}
+}
+
+// samplePoints returns at most n points, evenly spaced through points.
+func samplePoints(points []*pb.Point, n int) []*pb.Point {
+	if len(points) <= n {
+		return points
+	}
+	sampled := make([]*pb.Point, n)
+	for i := range sampled {
+		sampled[i] = points[i*len(points)/n]
+	}
+	return sampled
+}
+
+// toDense returns points as a 3xN matrix, with one point per column.
+func toDense(points []*pb.Point) *mat64.Dense {
+	m := mat64.NewDense(3, len(points), nil)
+	for j, p := range points {
+		m.Set(0, j, float64(p.X))
+		m.Set(1, j, float64(p.Y))
+		m.Set(2, j, float64(p.Z))
+	}
+	return m
+}
+
+func (p pose) toDense() *mat64.Dense {
+	return mat64.NewDense(4, 4, append([]float64(nil), p[:]...))
+}
+
+func poseFromDense(m *mat64.Dense) pose {
+	var p pose
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			p[i*4+j] = m.At(i, j)
+		}
+	}
+	return p
+}
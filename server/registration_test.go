@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+	"golang.org/x/net/context"
+)
+
+// slopedFrame returns a width by height frame of a floor that gets farther
+// away toward the top of the frame, which ICP can align with itself.
+func slopedFrame(width, height int) *pb.Depth {
+	d := testFrame(width, height, 0)
+	for r, row := range d.Rows {
+		for c := range row.Values {
+			row.Values[c] = int32(2000 - 20*r + 3*c)
+		}
+	}
+	return d
+}
+
+func TestRegisterAfterEmptyFrame(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		desc           string
+		depth          *pb.Depth
+		wantRegistered bool
+	}{
+		{"first frame", slopedFrame(48, 36), false},
+		{"frame without readings", testFrame(48, 36, 0), false},
+		{"frame after the empty one", slopedFrame(48, 36), true},
+	} {
+		resp, err := s.Add(ctx, &pb.AddRequest{Name: "p", Depth: tc.depth})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.desc, err)
+		}
+		if got := resp.Registration.Registered; got != tc.wantRegistered {
+			t.Errorf("%s: got registered %v. want %v", tc.desc, got, tc.wantRegistered)
+		}
+	}
+}
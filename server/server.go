@@ -1,52 +1,227 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"net"
 	"os"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
 	pb "github.com/omustardo/scanner/protos/meshbuilder"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 const port = ":50051"
 
-type project struct {
-	points []*pb.Point
-}
+var (
+	dataDir          = flag.String("data_dir", "", "Directory to persist projects in. If empty, projects are only kept in memory.")
+	maxProjectPoints = flag.Int("max_project_points", 0, "Most points a project may hold. Frames that would take a project past this are rejected. If 0, projects are unlimited.")
+	streamBuffer     = flag.Int("stream_buffer", 4, "Number of frames an AddStream call receives ahead of the frame being processed. Once full, clients are blocked from sending more.")
+)
+
+const (
+	// Points per RetrieveStream response when the client doesn't ask for a
+	// size, and the most it may ask for. A Point is ~17 bytes on the wire, so
+	// responses stay well under gRPC's default 4 MB message limit.
+	defaultStreamChunk = 10000
+	maxStreamChunk     = 100000
+)
 
 type Server struct {
 	pb.MeshBuilderServer
 
-	projects map[string]project
+	// Guards the projects map, but not the projects themselves.
+	mu       sync.RWMutex
+	projects map[string]*project
+	store    store
+}
+
+// getProject returns the named project, or nil if it doesn't exist.
+func (s *Server) getProject(name string) *project {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.projects[name]
 }
 
 func (s *Server) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
-	if _, ok := s.projects[req.Name]; ok {
-		return nil, fmt.Errorf("project already exists with name %q", req.Name)
+	if req.Name == "" {
+		return nil, invalidArgument("", fieldErrorf("name", "expected a project name"))
+	}
+	if err := validatePreprocessing(req.Preprocessing); err != nil {
+		return nil, invalidArgument("preprocessing", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.projects[req.Name]; ok {
+		if !req.GetOrCreate {
+			return nil, projectExists(req.Name)
+		}
+		existing.mu.Lock()
+		defer existing.mu.Unlock()
+		log.Println("Resuming project:", req.Name)
+		return &pb.CreateProjectResponse{Id: existing.id, Project: existing.info()}, nil
+	}
+	p := newProject(newProjectID(), req.Name, time.Now())
+	p.preprocessing = req.Preprocessing
+	if err := s.store.createProject(p.name, p.id, p.created, p.preprocessing); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store project %q: %v", req.Name, err)
 	}
-	s.projects[req.Name] = project{}
+	s.projects[req.Name] = p
 	log.Println("Created project:", req.Name)
-	return &pb.CreateProjectResponse{}, nil
+	return &pb.CreateProjectResponse{Id: p.id, Project: p.info(), Created: true}, nil
 }
 
 func (s *Server) Add(ctx context.Context, req *pb.AddRequest) (*pb.AddResponse, error) {
-	if _, ok := s.projects[req.Name]; !ok {
-		return nil, fmt.Errorf("unknown project: %q", req.Name)
+	project := s.getProject(req.Name)
+	if project == nil {
+		return nil, projectNotFound(req.Name)
 	}
-	log.Println("Add request for", len(req.Depth.Rows), "rows")
-	project := s.projects[req.Name]
-	newPoints := processDepth(req.GetDepth())
-	project.points = append(project.points, newPoints...)
-	s.projects[req.Name] = project
-	//log.Println("Added stuff. Project", req.Name, "has", len(project.points), " points.")
-	return &pb.AddResponse{}, nil
+	if req.GetDepth().GetPacked() != nil {
+		log.Println("Add request for a packed", req.Depth.Packed.Width, "by", req.Depth.Packed.Height, "frame")
+	} else {
+		log.Println("Add request for", len(req.GetDepth().GetRows()), "rows")
+	}
+	img, err := validateDepth(req.GetDepth())
+	if err != nil {
+		return nil, invalidArgument("depth", err)
+	}
+	_, reg, err := s.addFrame(project, req.GetDepth(), img, 0, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddResponse{Registration: reg.toProto()}, nil
+}
+
+// addFrame processes a depth frame, registers it with the project, stores it,
+// and appends it to the project. img is the frame's image, as returned by
+// validateDepth.
+func (s *Server) addFrame(project *project, depth *pb.Depth, img depthImage, sequence uint64, timestamp time.Time) (*frame, registration, error) {
+	// Processing and registration are the slow parts of adding a frame, so
+	// they're done without holding the project's lock. If another frame is
+	// added in the meantime, this one is still registered correctly against
+	// the frame before that, since every frame's points are in world space.
+	project.mu.Lock()
+	prev := project.registrationTarget()
+	preprocessing := project.preprocessing
+	project.mu.Unlock()
+	cameraPoints, in := processDepth(depth, img, preprocessing.GetDepthFilters())
+	cameraPoints = preprocess(cameraPoints, preprocessing)
+	cameraPoints = downsampleFrame(cameraPoints)
+	reg := registerFrame(cameraPoints, prev)
+
+	f := &frame{
+		sequence:   sequence,
+		timestamp:  timestamp,
+		depth:      depth,
+		intrinsics: in,
+		pose:       reg.pose,
+		points:     make([]*pb.Point, len(cameraPoints)),
+	}
+	for i, p := range cameraPoints {
+		f.points[i] = reg.pose.apply(p)
+	}
+
+	project.mu.Lock()
+	defer project.mu.Unlock()
+	if project.deleted {
+		return nil, registration{}, status.Errorf(codes.NotFound, "project %q was deleted", project.name)
+	}
+	added := project.newPoints(f.points)
+	if *maxProjectPoints > 0 && len(project.points)+len(added) > *maxProjectPoints {
+		return nil, registration{}, status.Errorf(codes.ResourceExhausted, "project %q has %d points. adding %d more would exceed the limit of %d", project.name, len(project.points), len(added), *maxProjectPoints)
+	}
+	if err := s.store.addFrame(project.name, f); err != nil {
+		return nil, registration{}, status.Errorf(codes.Internal, "failed to store frame for project %q: %v", project.name, err)
+	}
+	project.add(f, added, time.Now())
+	//log.Println("Added stuff. Project", project.name, "has", len(project.points), " points.")
+	return f, reg, nil
+}
+
+func (s *Server) AddStream(stream pb.MeshBuilder_AddStreamServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return stream.SendAndClose(&pb.AddStreamResponse{})
+	}
+	if err != nil {
+		return err
+	}
+	project := s.getProject(first.Name)
+	if project == nil {
+		return projectNotFound(first.Name)
+	}
+	log.Println("AddStream started for project", first.Name)
+
+	// Frames are received on their own goroutine so that receiving the next
+	// frame overlaps with processing the current one. The channel's buffer
+	// bounds how far ahead of processing receiving can get. Once it's full,
+	// Recv stops being called and gRPC's flow control blocks the client.
+	frames := make(chan *pb.AddStreamRequest, *streamBuffer)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(frames)
+		req := first
+		for {
+			select {
+			case frames <- req:
+			case <-stream.Context().Done():
+				return
+			}
+			var err error
+			req, err = stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					recvErr <- err
+				}
+				return
+			}
+		}
+	}()
+
+	summary := &pb.AddStreamResponse{}
+	var lastSequence uint64
+	for req := range frames {
+		if summary.FramesAccepted > 0 && req.Sequence <= lastSequence {
+			log.Printf("Dropping frame %d from stream for project %q: already at frame %d", req.Sequence, first.Name, lastSequence)
+			summary.FramesDropped++
+			continue
+		}
+		img, err := validateDepth(req.GetDepth())
+		if err != nil {
+			log.Printf("Dropping frame %d from stream for project %q: %v", req.Sequence, first.Name, err)
+			summary.FramesDropped++
+			continue
+		}
+		timestamp := time.Now()
+		if req.Timestamp != 0 {
+			timestamp = time.Unix(0, req.Timestamp)
+		}
+		f, _, err := s.addFrame(project, req.GetDepth(), img, req.Sequence, timestamp)
+		if err != nil {
+			return err
+		}
+		lastSequence = req.Sequence
+		summary.FramesAccepted++
+		summary.PointsAdded += int64(len(f.points))
+	}
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+	}
+	log.Printf("AddStream finished for project %q: %v", first.Name, summary)
+	return stream.SendAndClose(summary)
 }
 
 func toFile(req *pb.AddRequest) {
@@ -63,55 +238,346 @@ func toFile(req *pb.AddRequest) {
 	log.Println("wrote points to path:", path)
 }
 
-func processDepth(depth *pb.Depth) []*pb.Point {
-	if validateDepth(depth) != nil {
-		return nil
+// intrinsics describes the pinhole camera that captured a depth frame. It's
+// derived from the frame's image dimensions and field of view.
+type intrinsics struct {
+	// Focal lengths in pixels.
+	fx, fy float64
+	// Principal point in pixels.
+	cx, cy float64
+}
+
+func makeIntrinsics(width, height int, xFOV, yFOV float32) intrinsics {
+	return intrinsics{
+		fx: float64(width) / 2 / math.Tan(degreesToRadians(xFOV)/2),
+		fy: float64(height) / 2 / math.Tan(degreesToRadians(yFOV)/2),
+		cx: float64(width-1) / 2,
+		cy: float64(height-1) / 2,
 	}
-	points := []*pb.Point{}
-	for row := range depth.Rows {
-		if depth.Rows[row] == nil {
-			continue
+}
+
+func degreesToRadians(degrees float32) float64 {
+	return float64(degrees) * math.Pi / 180
+}
+
+// deproject returns the camera space position, in meters, of the pixel at
+// (row, col) whose depth is z meters. The camera looks down +Z with +X to the
+// right and +Y up, so Y decreases as the row index increases.
+func (in intrinsics) deproject(row, col int, z float64) *pb.Point {
+	x := (float64(col) - in.cx) * z / in.fx
+	y := (in.cy - float64(row)) * z / in.fy
+	return &pb.Point{X: float32(x), Y: float32(y), Z: float32(z)}
+}
+
+// processDepth returns the camera space points from a depth frame, along with
+// the intrinsics of the camera that captured it. img is the frame's image, as
+// returned by validateDepth, and is converted to meters in place. filters are
+// applied in order to the converted image before it's turned into points.
+// They're expected to have been validated.
+func processDepth(depth *pb.Depth, img depthImage, filters []*pb.DepthFilter) ([]*pb.Point, intrinsics) {
+	toMeters, err := converterFor(depth)
+	if err != nil {
+		return nil, intrinsics{}
+	}
+	fs, err := depthFiltersFor(filters)
+	if err != nil {
+		log.Println("Failed to filter depth:", err)
+		fs = nil
+	}
+	for i, v := range img.values {
+		z, ok := toMeters(v)
+		if !ok {
+			// The sensor didn't get a reading for this pixel.
+			z = 0
 		}
-		for col, value := range depth.Rows[row].Values {
-			points = append(points, &pb.Point{X: float32(row), Y: float32(col), Z: float32(value)})
+		img.values[i] = z
+	}
+	for _, filter := range fs {
+		img = filter(img)
+	}
+	points := []*pb.Point{}
+	in := makeIntrinsics(img.width, img.height, depth.XFov, depth.YFov)
+	for row := 0; row < img.height; row++ {
+		for col := 0; col < img.width; col++ {
+			if z := img.at(row, col); z > 0 {
+				points = append(points, in.deproject(row, col, z))
+			}
 		}
 	}
-	return points
+	return points, in
 }
 
-func validateDepth(d *pb.Depth) error {
-	if d == nil || d.Rows == nil {
-		return nil
+// validateDepth returns a fieldError describing the first problem found with
+// a depth frame, or the frame's decoded image if it can be processed. Decoding
+// is the costly part of validating a frame, so the image is returned for
+// processDepth rather than decoded again.
+func validateDepth(d *pb.Depth) (depthImage, error) {
+	if d == nil {
+		return depthImage{}, fieldErrorf("", "expected a depth frame")
 	}
-	width := len(d.Rows[0].Values)
-	for i := range d.Rows {
-		if len(d.Rows[i].Values) != width {
-			return fmt.Errorf("expected all rows in depth to be of equal size. got %v and %v", width, len(d.Rows[i].Values))
-		}
+	if _, err := converterFor(d); err != nil {
+		return depthImage{}, err
+	}
+	img, err := decodeDepth(d)
+	if err != nil {
+		return depthImage{}, err
+	}
+	if img.height == 0 {
+		return depthImage{}, fieldErrorf("rows", "expected a non-empty depth frame")
+	}
+	if img.width == 0 {
+		return depthImage{}, fieldErrorf("rows[0]", "expected a non-empty depth frame")
+	}
+	if d.XFov <= 0 || d.XFov >= 180 {
+		return depthImage{}, fieldErrorf("x_fov", "expected field of view to be between 0 and 180 degrees. got %v", d.XFov)
 	}
-	return nil
+	if d.YFov <= 0 || d.YFov >= 180 {
+		return depthImage{}, fieldErrorf("y_fov", "expected field of view to be between 0 and 180 degrees. got %v", d.YFov)
+	}
+	return img, nil
 }
 
 func (s *Server) Retrieve(ctx context.Context, req *pb.RetrieveRequest) (*pb.RetrieveResponse, error) {
-	if _, ok := s.projects[req.Name]; !ok {
-		return nil, fmt.Errorf("unknown project: %q", req.Name)
+	project := s.getProject(req.Name)
+	if project == nil {
+		return nil, projectNotFound(req.Name)
 	}
+	project.mu.Lock()
+	// Later calls to Add only ever append, so this slice won't change after the
+	// lock is released.
+	points := project.points
+	project.mu.Unlock()
 	log.Println("Retrieving from project", req.Name)
-	log.Println(len(s.projects[req.Name].points), "values")
-	if len(s.projects[req.Name].points) > 3 {
-		log.Println(s.projects[req.Name].points[:3])
+	log.Println(len(points), "values")
+	if len(points) > 3 {
+		log.Println(points[:3])
+	}
+	return &pb.RetrieveResponse{Points: points}, nil
+}
+
+func (s *Server) RetrieveStream(req *pb.RetrieveStreamRequest, stream pb.MeshBuilder_RetrieveStreamServer) error {
+	project := s.getProject(req.Name)
+	if project == nil {
+		return projectNotFound(req.Name)
+	}
+	chunk := int(req.MaxPoints)
+	if chunk <= 0 {
+		chunk = defaultStreamChunk
+	}
+	if chunk > maxStreamChunk {
+		chunk = maxStreamChunk
+	}
+	log.Printf("Streaming project %q in chunks of %d points. Watching: %v", req.Name, chunk, req.Watch)
+
+	project.mu.Lock()
+	clears := project.clears
+	project.mu.Unlock()
+	sent := 0
+	// At least one response is always sent, even if the project is empty, so
+	// that the client learns that it has caught up.
+	first := true
+	for {
+		project.mu.Lock()
+		if project.deleted {
+			project.mu.Unlock()
+			return status.Errorf(codes.NotFound, "project %q was deleted", req.Name)
+		}
+		if project.clears != clears {
+			// The points that were already sent no longer exist. It's up to the
+			// client to start over.
+			project.mu.Unlock()
+			return status.Errorf(codes.Aborted, "project %q was cleared", req.Name)
+		}
+		points := project.points[sent:]
+		changed := project.changes()
+		project.mu.Unlock()
+
+		for first || len(points) > 0 {
+			first = false
+			n := len(points)
+			if n > chunk {
+				n = chunk
+			}
+			sent += n
+			resp := &pb.RetrieveStreamResponse{
+				Points:     points[:n],
+				PointsSent: int64(sent),
+				CaughtUp:   n == len(points),
+			}
+			points = points[n:]
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		if !req.Watch {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (s *Server) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	s.mu.RLock()
+	projects := make([]*project, 0, len(s.projects))
+	for _, p := range s.projects {
+		projects = append(projects, p)
+	}
+	s.mu.RUnlock()
+
+	resp := &pb.ListProjectsResponse{}
+	for _, p := range projects {
+		p.mu.Lock()
+		resp.Projects = append(resp.Projects, p.info())
+		p.mu.Unlock()
+	}
+	sort.Slice(resp.Projects, func(i, j int) bool { return resp.Projects[i].Name < resp.Projects[j].Name })
+	return resp, nil
+}
+
+func (s *Server) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
+	project := s.getProject(req.Name)
+	if project == nil {
+		return nil, projectNotFound(req.Name)
+	}
+	project.mu.Lock()
+	defer project.mu.Unlock()
+	return &pb.GetProjectResponse{Project: project.info()}, nil
+}
+
+func (s *Server) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	project := s.projects[req.Name]
+	if project == nil {
+		return nil, projectNotFound(req.Name)
+	}
+	project.mu.Lock()
+	defer project.mu.Unlock()
+	if err := s.store.deleteProject(req.Name); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete project %q: %v", req.Name, err)
 	}
-	return &pb.RetrieveResponse{Points: s.projects[req.Name].points}, nil
+	delete(s.projects, req.Name)
+	project.deleted = true
+	project.notify()
+	log.Println("Deleted project:", req.Name)
+	return &pb.DeleteProjectResponse{}, nil
+}
+
+func (s *Server) RenameProject(ctx context.Context, req *pb.RenameProjectRequest) (*pb.RenameProjectResponse, error) {
+	if req.NewName == "" {
+		return nil, invalidArgument("", fieldErrorf("new_name", "expected a project name"))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	project := s.projects[req.Name]
+	if project == nil {
+		return nil, projectNotFound(req.Name)
+	}
+	if _, ok := s.projects[req.NewName]; ok {
+		return nil, projectExists(req.NewName)
+	}
+	project.mu.Lock()
+	defer project.mu.Unlock()
+	if err := s.store.renameProject(req.Name, req.NewName); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rename project %q: %v", req.Name, err)
+	}
+	delete(s.projects, req.Name)
+	s.projects[req.NewName] = project
+	project.name = req.NewName
+	log.Printf("Renamed project %q to %q", req.Name, req.NewName)
+	return &pb.RenameProjectResponse{}, nil
+}
+
+func (s *Server) ClearProject(ctx context.Context, req *pb.ClearProjectRequest) (*pb.ClearProjectResponse, error) {
+	project := s.getProject(req.Name)
+	if project == nil {
+		return nil, projectNotFound(req.Name)
+	}
+	project.mu.Lock()
+	defer project.mu.Unlock()
+	if project.deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q was deleted", req.Name)
+	}
+	if err := s.store.clearProject(project.name); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clear project %q: %v", req.Name, err)
+	}
+	project.clear(time.Now())
+	log.Println("Cleared project:", req.Name)
+	return &pb.ClearProjectResponse{}, nil
+}
+
+func (s *Server) SetPreprocessing(ctx context.Context, req *pb.SetPreprocessingRequest) (*pb.SetPreprocessingResponse, error) {
+	if err := validatePreprocessing(req.Preprocessing); err != nil {
+		return nil, invalidArgument("preprocessing", err)
+	}
+	project := s.getProject(req.Name)
+	if project == nil {
+		return nil, projectNotFound(req.Name)
+	}
+	project.mu.Lock()
+	defer project.mu.Unlock()
+	if project.deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q was deleted", req.Name)
+	}
+	if err := s.store.setPreprocessing(project.name, req.Preprocessing); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store preprocessing for project %q: %v", req.Name, err)
+	}
+	project.preprocessing = req.Preprocessing
+	log.Printf("Set preprocessing for project %q: %v", req.Name, req.Preprocessing)
+	return &pb.SetPreprocessingResponse{}, nil
 }
 
 func main() {
+	flag.Parse()
+	if _, ok := registrationMetrics[*registrationMetric]; !ok {
+		log.Fatalf("unknown registration_metric: %q", *registrationMetric)
+	}
+	if _, ok := voxelModes[*frameVoxelMode]; !ok {
+		log.Fatalf("unknown frame_voxel_mode: %q", *frameVoxelMode)
+	}
+	if *frameVoxelSize < 0 || *projectVoxelSize < 0 {
+		log.Fatal("frame_voxel_size and project_voxel_size may not be negative")
+	}
+	if *streamBuffer < 0 {
+		log.Fatal("stream_buffer may not be negative")
+	}
 	lis, err := net.Listen("tcp", port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	meshBuilder := &Server{}
-	meshBuilder.projects = make(map[string]project)
-	meshBuilder.projects["test"] = project{points: []*pb.Point{{X: 10, Y: 10, Z: 10}}}
+	meshBuilder := &Server{store: memoryStore{}}
+	if *dataDir != "" {
+		meshBuilder.store, err = newDirStore(*dataDir)
+		if err != nil {
+			log.Fatalf("failed to open data directory: %v", err)
+		}
+	}
+	meshBuilder.projects, err = meshBuilder.store.load()
+	if err != nil {
+		log.Fatalf("failed to load projects: %v", err)
+	}
+	if _, ok := meshBuilder.projects["test"]; !ok {
+		// Stored like any other project, so that it can be added to, cleared
+		// and renamed like one.
+		test := newProject(newProjectID(), "test", time.Now())
+		f := &frame{
+			timestamp: test.created,
+			pose:      identityPose,
+			points:    []*pb.Point{{X: 10, Y: 10, Z: 10}},
+		}
+		if err := meshBuilder.store.createProject(test.name, test.id, test.created, nil); err != nil {
+			log.Fatalf("failed to store test project: %v", err)
+		}
+		if err := meshBuilder.store.addFrame(test.name, f); err != nil {
+			log.Fatalf("failed to store test project: %v", err)
+		}
+		test.add(f, test.newPoints(f.points), test.created)
+		meshBuilder.projects["test"] = test
+	}
 	s := grpc.NewServer()
 	pb.RegisterMeshBuilderServer(s, meshBuilder)
 	// Register reflection service on gRPC server.
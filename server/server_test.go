@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestServer returns a Server that keeps its projects in memory.
+func newTestServer() *Server {
+	return &Server{store: memoryStore{}, projects: make(map[string]*project)}
+}
+
+// testFrame returns a width by height frame whose every pixel is mm
+// millimeters away.
+func testFrame(width, height int, mm int32) *pb.Depth {
+	d := &pb.Depth{XFov: 58.5, YFov: 46.6, Encoding: pb.Depth_MILLIMETERS}
+	for r := 0; r < height; r++ {
+		row := &pb.Row{Values: make([]int32, width)}
+		for c := range row.Values {
+			row.Values[c] = mm
+		}
+		d.Rows = append(d.Rows, row)
+	}
+	return d
+}
+
+// Run with -race. Adds frames of different sizes to one project from many
+// goroutines while others read it, and checks that none were lost.
+func TestConcurrentAdd(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); err != nil {
+		t.Fatal(err)
+	}
+
+	const adders = 32
+	wantPoints := 0
+	var wg sync.WaitGroup
+	for i := 0; i < adders; i++ {
+		width, height := 3+i%4, 2+i%3
+		wantPoints += width * height
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := s.Add(ctx, &pb.AddRequest{Name: "p", Depth: testFrame(width, height, 1000)}); err != nil {
+				t.Errorf("Add: %v", err)
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			if _, err := s.Retrieve(ctx, &pb.RetrieveRequest{Name: "p"}); err != nil {
+				t.Errorf("Retrieve: %v", err)
+			}
+			if _, err := s.ListProjects(ctx, &pb.ListProjectsRequest{}); err != nil {
+				t.Errorf("ListProjects: %v", err)
+			}
+			// Creating other projects writes to the projects map while Add
+			// reads it.
+			if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: fmt.Sprint("other", i)}); err != nil {
+				t.Errorf("CreateProject: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	resp, err := s.GetProject(ctx, &pb.GetProjectRequest{Name: "p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.Project.FrameCount; got != adders {
+		t.Errorf("got %d frames. want %d", got, adders)
+	}
+	if got := resp.Project.PointCount; got != int64(wantPoints) {
+		t.Errorf("got %d points. want %d", got, wantPoints)
+	}
+	retrieved, err := s.Retrieve(ctx, &pb.RetrieveRequest{Name: "p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(retrieved.Points); got != wantPoints {
+		t.Errorf("retrieved %d points. want %d", got, wantPoints)
+	}
+}
+
+// badRequestField returns the field named by err's BadRequest detail, or "" if
+// it doesn't have one.
+func badRequestField(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok && len(br.FieldViolations) > 0 {
+			return br.FieldViolations[0].Field
+		}
+	}
+	return ""
+}
+
+func TestNotFound(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	for _, tc := range []struct {
+		handler string
+		call    func() error
+	}{
+		{"Add", func() error {
+			_, err := s.Add(ctx, &pb.AddRequest{Name: "missing", Depth: testFrame(4, 3, 1000)})
+			return err
+		}},
+		{"Retrieve", func() error {
+			_, err := s.Retrieve(ctx, &pb.RetrieveRequest{Name: "missing"})
+			return err
+		}},
+		{"GetProject", func() error {
+			_, err := s.GetProject(ctx, &pb.GetProjectRequest{Name: "missing"})
+			return err
+		}},
+		{"DeleteProject", func() error {
+			_, err := s.DeleteProject(ctx, &pb.DeleteProjectRequest{Name: "missing"})
+			return err
+		}},
+		{"RenameProject", func() error {
+			_, err := s.RenameProject(ctx, &pb.RenameProjectRequest{Name: "missing", NewName: "other"})
+			return err
+		}},
+		{"ClearProject", func() error {
+			_, err := s.ClearProject(ctx, &pb.ClearProjectRequest{Name: "missing"})
+			return err
+		}},
+		{"SetPreprocessing", func() error {
+			_, err := s.SetPreprocessing(ctx, &pb.SetPreprocessingRequest{Name: "missing"})
+			return err
+		}},
+	} {
+		if err := tc.call(); status.Code(err) != codes.NotFound {
+			t.Errorf("%s: got %v. want %v", tc.handler, err, codes.NotFound)
+		}
+	}
+}
+
+func TestCreateProjectAlreadyExists(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("got %v. want %v", err, codes.AlreadyExists)
+	}
+}
+
+func TestAddInvalidArgument(t *testing.T) {
+	s := newTestServer()
+	ctx := context.Background()
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	ragged := testFrame(4, 3, 1000)
+	ragged.Rows[1].Values = ragged.Rows[1].Values[:2]
+	wideFOV := testFrame(4, 3, 1000)
+	wideFOV.XFov = 200
+	noEncoding := testFrame(4, 3, 1000)
+	noEncoding.Encoding = pb.Depth_ENCODING_UNSPECIFIED
+	for _, tc := range []struct {
+		desc  string
+		depth *pb.Depth
+		field string
+	}{
+		{"missing frame", nil, "depth"},
+		{"ragged row", ragged, "depth.rows[1]"},
+		{"empty frame", &pb.Depth{XFov: 58.5, YFov: 46.6, Encoding: pb.Depth_MILLIMETERS}, "depth.rows"},
+		{"out of range x_fov", wideFOV, "depth.x_fov"},
+		{"no encoding", noEncoding, "depth.encoding"},
+		{"bad bit depth", &pb.Depth{XFov: 58.5, YFov: 46.6, Encoding: pb.Depth_MILLIMETERS, Packed: &pb.PackedDepth{Width: 1, Height: 1, BitDepth: 12}}, "depth.packed.bit_depth"},
+	} {
+		_, err := s.Add(ctx, &pb.AddRequest{Name: "p", Depth: tc.depth})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got %v. want %v", tc.desc, err, codes.InvalidArgument)
+			continue
+		}
+		if got := badRequestField(err); got != tc.field {
+			t.Errorf("%s: got field %q. want %q", tc.desc, got, tc.field)
+		}
+	}
+}
+
+func TestAddResourceExhausted(t *testing.T) {
+	defer func(limit int) { *maxProjectPoints = limit }(*maxProjectPoints)
+	*maxProjectPoints = 20
+
+	s := newTestServer()
+	ctx := context.Background()
+	if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: "p"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Add(ctx, &pb.AddRequest{Name: "p", Depth: testFrame(4, 3, 1000)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Add(ctx, &pb.AddRequest{Name: "p", Depth: testFrame(4, 3, 1000)}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got %v. want %v", err, codes.ResourceExhausted)
+	}
+	resp, err := s.GetProject(ctx, &pb.GetProjectRequest{Name: "p"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Project.FrameCount != 1 || resp.Project.PointCount != 12 {
+		t.Errorf("got %d frames and %d points after the rejected frame. want 1 and 12", resp.Project.FrameCount, resp.Project.PointCount)
+	}
+}
@@ -0,0 +1,354 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+)
+
+// store persists projects so that they survive server restarts. Server keeps
+// its own in memory copy of every project and calls into the store as projects
+// change. Implementations must be safe to call from multiple goroutines,
+// though Server never makes concurrent calls for the same project.
+type store interface {
+	// load returns every persisted project, keyed by name.
+	load() (map[string]*project, error)
+	createProject(name, id string, created time.Time, preprocessing *pb.Preprocessing) error
+	// addFrame persists a frame that was added to a project, along with the
+	// points derived from it.
+	addFrame(name string, f *frame) error
+	deleteProject(name string) error
+	renameProject(name, newName string) error
+	// clearProject removes every frame stored for a project.
+	clearProject(name string) error
+	setPreprocessing(name string, preprocessing *pb.Preprocessing) error
+}
+
+// memoryStore doesn't persist anything. Projects only live as long as the
+// server does.
+type memoryStore struct{}
+
+func (memoryStore) load() (map[string]*project, error) { return map[string]*project{}, nil }
+func (memoryStore) createProject(name, id string, _ time.Time, _ *pb.Preprocessing) error {
+	return nil
+}
+func (memoryStore) addFrame(name string, _ *frame) error                    { return nil }
+func (memoryStore) deleteProject(name string) error                         { return nil }
+func (memoryStore) renameProject(name, newName string) error                { return nil }
+func (memoryStore) clearProject(name string) error                          { return nil }
+func (memoryStore) setPreprocessing(name string, _ *pb.Preprocessing) error { return nil }
+
+const (
+	projectDirPrefix = "project-"
+	// Directories being deleted are renamed to start with this first, so that
+	// a partially deleted project is never loaded.
+	deletedDirPrefix = "deleted-"
+	// Holds a ProjectInfo with the project's ID, creation time and
+	// preprocessing.
+	projectInfoFile = "project.info"
+	frameExt        = ".frame"
+	pointsExt       = ".points"
+)
+
+// dirStore persists projects in a directory on disk. Each project gets its own
+// subdirectory holding a projectInfoFile and one pair of files per frame: a
+// Frame proto with the raw depth, and a RetrieveResponse proto with the points
+// derived from it. Frame files are named after the frame's index in the
+// project so they can be reloaded in order.
+type dirStore struct {
+	root string
+
+	// Guards frameCounts.
+	mu sync.Mutex
+	// Number of frames stored for each project.
+	frameCounts map[string]int
+}
+
+func newDirStore(root string) (*dirStore, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	return &dirStore{root: root, frameCounts: make(map[string]int)}, nil
+}
+
+// projectDir returns the directory for a project. Names are escaped so that
+// they can't refer to anything outside of the store's root.
+func (s *dirStore) projectDir(name string) string {
+	return filepath.Join(s.root, projectDirPrefix+url.PathEscape(name))
+}
+
+func (s *dirStore) load() (map[string]*project, error) {
+	projects := make(map[string]*project)
+	entries, err := ioutil.ReadDir(s.root)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), deletedDirPrefix) {
+			// Left behind by a deletion that didn't finish.
+			if err := os.RemoveAll(filepath.Join(s.root, entry.Name())); err != nil {
+				log.Printf("Failed to finish deleting %s: %v", entry.Name(), err)
+			}
+			continue
+		}
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), projectDirPrefix) {
+			continue
+		}
+		name, err := url.PathUnescape(strings.TrimPrefix(entry.Name(), projectDirPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("bad project directory %q: %v", entry.Name(), err)
+		}
+		p, err := s.loadProject(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load project %q: %v", name, err)
+		}
+		projects[name] = p
+		s.mu.Lock()
+		s.frameCounts[name] = len(p.frames)
+		s.mu.Unlock()
+		log.Printf("Loaded project %q: %d frames, %d points", name, len(p.frames), len(p.points))
+	}
+	return projects, nil
+}
+
+// loadProject reads a project's info and every frame stored for it.
+func (s *dirStore) loadProject(name string) (*project, error) {
+	dir := s.projectDir(name)
+	infoPath := filepath.Join(dir, projectInfoFile)
+	data, err := ioutil.ReadFile(infoPath)
+	if err != nil {
+		return nil, err
+	}
+	info := &pb.ProjectInfo{}
+	if err := proto.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("%s: %v", projectInfoFile, err)
+	}
+	p := newProject(info.Id, name, time.Unix(0, info.Created))
+	p.preprocessing = info.Preprocessing
+	// clearProject touches the info file to record when it was cleared.
+	if stat, err := os.Stat(infoPath); err == nil && stat.ModTime().After(p.updated) {
+		p.updated = stat.ModTime()
+	}
+
+	frames, err := s.frameFiles(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, base := range frames {
+		f, modified, err := loadFrame(filepath.Join(dir, base))
+		if err != nil {
+			return nil, fmt.Errorf("frame %s: %v", base, err)
+		}
+		p.add(f, p.newPoints(f.points), modified)
+	}
+	return p, nil
+}
+
+// loadFrame reads the files for a single frame, given their path without an
+// extension. It also returns when the frame was written.
+func loadFrame(base string) (*frame, time.Time, error) {
+	data, err := ioutil.ReadFile(base + pointsExt)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	resp := &pb.RetrieveResponse{}
+	if err := proto.Unmarshal(data, resp); err != nil {
+		return nil, time.Time{}, err
+	}
+	stat, err := os.Stat(base + pointsExt)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	data, err = ioutil.ReadFile(base + frameExt)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	msg := &pb.Frame{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, time.Time{}, err
+	}
+	pose, err := poseFromProto(msg.Pose)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	f := &frame{
+		sequence: msg.Sequence,
+		depth:    msg.Depth,
+		pose:     pose,
+		points:   resp.Points,
+	}
+	if msg.Timestamp != 0 {
+		f.timestamp = time.Unix(0, msg.Timestamp)
+	}
+	if img, err := decodeDepth(msg.GetDepth()); err == nil && len(img.values) > 0 {
+		f.intrinsics = makeIntrinsics(img.width, img.height, msg.Depth.XFov, msg.Depth.YFov)
+	}
+	return f, stat.ModTime(), nil
+}
+
+// frameFiles returns the base names of every complete frame stored for a
+// project, in the order they were added.
+func (s *dirStore) frameFiles(name string) ([]string, error) {
+	files, err := ioutil.ReadDir(s.projectDir(name))
+	if err != nil {
+		return nil, err
+	}
+	var frames []string
+	for _, f := range files {
+		if strings.HasSuffix(f.Name(), pointsExt) {
+			frames = append(frames, strings.TrimSuffix(f.Name(), pointsExt))
+		}
+	}
+	// Frame file names are zero padded, so sorting them as strings puts them in
+	// the order they were added.
+	sort.Strings(frames)
+	return frames, nil
+}
+
+func (s *dirStore) createProject(name, id string, created time.Time, preprocessing *pb.Preprocessing) error {
+	dir := s.projectDir(name)
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("project %q is already stored in %s", name, dir)
+	}
+	if err := os.Mkdir(dir, 0755); err != nil {
+		return err
+	}
+	info := &pb.ProjectInfo{Id: id, Created: created.UnixNano(), Preprocessing: preprocessing}
+	if err := writeProto(filepath.Join(dir, projectInfoFile), info); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.frameCounts[name] = 0
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *dirStore) addFrame(name string, f *frame) error {
+	s.mu.Lock()
+	index := s.frameCounts[name]
+	s.mu.Unlock()
+	base := filepath.Join(s.projectDir(name), fmt.Sprintf("%08d", index))
+	if err := writeProto(base+frameExt, f.toProto()); err != nil {
+		return err
+	}
+	// The points file is written last since its presence is what marks a frame
+	// as complete when loading.
+	if err := writeProto(base+pointsExt, &pb.RetrieveResponse{Points: f.points}); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.frameCounts[name] = index + 1
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *dirStore) deleteProject(name string) error {
+	tmp, err := ioutil.TempDir(s.root, deletedDirPrefix)
+	if err != nil {
+		return err
+	}
+	// Move the project inside of the temporary directory so that a failed
+	// deletion can't leave a partial project behind to be loaded.
+	if err := os.Rename(s.projectDir(name), filepath.Join(tmp, projectDirPrefix)); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	s.mu.Lock()
+	delete(s.frameCounts, name)
+	s.mu.Unlock()
+	if err := os.RemoveAll(tmp); err != nil {
+		// The project is already gone as far as load is concerned, so this
+		// isn't worth failing over. load tries again on the next startup.
+		log.Printf("Failed to finish deleting project %q: %v", name, err)
+	}
+	return nil
+}
+
+func (s *dirStore) renameProject(name, newName string) error {
+	dir := s.projectDir(newName)
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("project %q is already stored in %s", newName, dir)
+	}
+	if err := os.Rename(s.projectDir(name), dir); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.frameCounts[newName] = s.frameCounts[name]
+	delete(s.frameCounts, name)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *dirStore) clearProject(name string) error {
+	frames, err := s.frameFiles(name)
+	if err != nil {
+		return err
+	}
+	dir := s.projectDir(name)
+	// Points files go first since they mark frames as complete. Removing them
+	// in reverse order means that if this fails partway through, the frames
+	// left behind are still the first ones that were added.
+	for i := len(frames) - 1; i >= 0; i-- {
+		if err := os.Remove(filepath.Join(dir, frames[i]+pointsExt)); err != nil {
+			return err
+		}
+	}
+	for _, base := range frames {
+		if err := os.Remove(filepath.Join(dir, base+frameExt)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	s.mu.Lock()
+	s.frameCounts[name] = 0
+	s.mu.Unlock()
+	now := time.Now()
+	return os.Chtimes(filepath.Join(dir, projectInfoFile), now, now)
+}
+
+func (s *dirStore) setPreprocessing(name string, preprocessing *pb.Preprocessing) error {
+	path := filepath.Join(s.projectDir(name), projectInfoFile)
+	stat, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	info := &pb.ProjectInfo{}
+	if err := proto.Unmarshal(data, info); err != nil {
+		return fmt.Errorf("%s: %v", projectInfoFile, err)
+	}
+	info.Preprocessing = preprocessing
+	if err := writeProto(path, info); err != nil {
+		return err
+	}
+	// The info file's modification time records when the project was last
+	// cleared, which this isn't.
+	return os.Chtimes(path, stat.ModTime(), stat.ModTime())
+}
+
+// writeProto atomically writes a proto to path by writing to a temporary file
+// and then renaming it.
+func writeProto(path string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/omustardo/scanner/protos/meshbuilder"
+	"golang.org/x/net/context"
+)
+
+// restart loads every project in root as a freshly started server would.
+func restart(t *testing.T, root string) *Server {
+	st, err := newDirStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects, err := st.load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Server{store: st, projects: projects}
+}
+
+func projectInfo(t *testing.T, s *Server, name string) *pb.ProjectInfo {
+	resp, err := s.GetProject(context.Background(), &pb.GetProjectRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetProject(%q): %v", name, err)
+	}
+	return resp.Project
+}
+
+func TestDirStoreRestart(t *testing.T) {
+	root, err := ioutil.TempDir("", "dirstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	s := restart(t, root)
+	ctx := context.Background()
+
+	// Names are escaped, so one that looks like a path stays in root.
+	const escaped = "a/../b"
+	for _, name := range []string{escaped, "doomed"} {
+		if _, err := s.CreateProject(ctx, &pb.CreateProjectRequest{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := s.Add(ctx, &pb.AddRequest{Name: escaped, Depth: testFrame(4, 3, 1000)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.Add(ctx, &pb.AddRequest{Name: "doomed", Depth: testFrame(2, 2, 1000)}); err != nil {
+		t.Fatal(err)
+	}
+	before := projectInfo(t, s, escaped)
+
+	s = restart(t, root)
+	after := projectInfo(t, s, escaped)
+	if after.Id != before.Id || after.Created != before.Created || after.FrameCount != 2 || after.PointCount != 24 {
+		t.Errorf("got %v after restarting. want %v", after, before)
+	}
+	if got := projectInfo(t, s, "doomed"); got.FrameCount != 1 || got.PointCount != 4 {
+		t.Errorf("got %d frames and %d points after restarting. want 1 and 4", got.FrameCount, got.PointCount)
+	}
+
+	// File modification times may be truncated to the second.
+	cleared := time.Now().Truncate(time.Second)
+	if _, err := s.ClearProject(ctx, &pb.ClearProjectRequest{Name: escaped}); err != nil {
+		t.Fatal(err)
+	}
+
+	s = restart(t, root)
+	got := projectInfo(t, s, escaped)
+	if got.FrameCount != 0 || got.PointCount != 0 {
+		t.Errorf("got %d frames and %d points after clearing. want none", got.FrameCount, got.PointCount)
+	}
+	if time.Unix(0, got.Updated).Before(cleared) {
+		t.Errorf("got updated time %v. want at least %v, when the project was cleared", time.Unix(0, got.Updated), cleared)
+	}
+	if _, err := s.RenameProject(ctx, &pb.RenameProjectRequest{Name: escaped, NewName: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Add(ctx, &pb.AddRequest{Name: "renamed", Depth: testFrame(3, 2, 1000)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.DeleteProject(ctx, &pb.DeleteProjectRequest{Name: "doomed"}); err != nil {
+		t.Fatal(err)
+	}
+	// Left behind by a deletion that was interrupted.
+	if err := os.MkdirAll(filepath.Join(root, deletedDirPrefix+"interrupted", projectDirPrefix), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	s = restart(t, root)
+	if len(s.projects) != 1 {
+		t.Errorf("got %d projects after restarting. want 1", len(s.projects))
+	}
+	got = projectInfo(t, s, "renamed")
+	if got.Id != before.Id || got.Created != before.Created {
+		t.Errorf("got ID %q created at %d after renaming. want %q and %d", got.Id, got.Created, before.Id, before.Created)
+	}
+	if got.FrameCount != 1 || got.PointCount != 6 {
+		t.Errorf("got %d frames and %d points after adding to the renamed project. want 1 and 6", got.FrameCount, got.PointCount)
+	}
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != projectDirPrefix+"renamed" {
+			t.Errorf("found %s in the store. want only the renamed project", entry.Name())
+		}
+	}
+}